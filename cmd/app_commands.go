@@ -0,0 +1,677 @@
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/sendpart"
+)
+
+// appCommandsTTL is how long the commands of a guild or DM are used before
+// they are fetched again.
+const appCommandsTTL = 10 * time.Minute
+
+// appCommand is an application command that can be invoked in a channel. Its
+// JSON is kept as is, as it is sent back with the interactions that invoke it.
+type appCommand struct {
+	discord.Command
+	raw json.RawMessage
+	// appName is the name of the application of the command.
+	appName string
+}
+
+func (c *appCommand) UnmarshalJSON(b []byte) error {
+	c.raw = slices.Clone(b)
+	return json.Unmarshal(b, &c.Command)
+}
+
+// appCommandIndex is the response of the application command index endpoints,
+// which list the commands that the current user can see in a guild, a DM or
+// everywhere, for the applications they installed.
+type appCommandIndex struct {
+	Applications []struct {
+		ID   discord.AppID `json:"id"`
+		Name string        `json:"name"`
+	} `json:"applications"`
+	Commands []appCommand `json:"application_commands"`
+}
+
+type appCommandsEntry struct {
+	commands  []appCommand
+	fetchedAt time.Time
+	fetching  bool
+}
+
+// appCommandStore holds the application commands of the guilds and DMs that
+// commands were typed in.
+type appCommandStore struct {
+	mu sync.Mutex
+	// entries maps the guilds, or the DM channels, to their commands.
+	entries map[discord.Snowflake]*appCommandsEntry
+}
+
+func newAppCommandStore() *appCommandStore {
+	return &appCommandStore{
+		entries: make(map[discord.Snowflake]*appCommandsEntry),
+	}
+}
+
+// appCommandsKey returns the key of the commands of the channel: its guild,
+// or the channel itself for DMs.
+func appCommandsKey(channel discord.Channel) discord.Snowflake {
+	if channel.GuildID.IsValid() {
+		return discord.Snowflake(channel.GuildID)
+	}
+	return discord.Snowflake(channel.ID)
+}
+
+// commands returns the commands of the channel and whether they were fetched.
+// They are fetched in the background if they were not or are out of date, and
+// onFetched is called afterwards if they were not.
+func (s *appCommandStore) commands(channel discord.Channel, onFetched func()) ([]appCommand, bool) {
+	key := appCommandsKey(channel)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &appCommandsEntry{}
+		s.entries[key] = e
+	}
+
+	loaded := !e.fetchedAt.IsZero()
+	if !e.fetching && (!loaded || time.Since(e.fetchedAt) > appCommandsTTL) {
+		e.fetching = true
+		go func() {
+			commands, err := fetchAppCommands(channel)
+			if err != nil {
+				slog.Error("failed to fetch application commands", "err", err, "channel_id", channel.ID, "guild_id", channel.GuildID)
+			}
+
+			s.mu.Lock()
+			e.fetching = false
+			e.fetchedAt = time.Now()
+			if err == nil {
+				e.commands = commands
+			}
+			s.mu.Unlock()
+
+			if !loaded && onFetched != nil {
+				onFetched()
+			}
+		}()
+	}
+
+	return e.commands, loaded
+}
+
+// fetchAppCommands fetches the chat input commands of the guild or DM of the
+// channel and those of the applications that the current user installed,
+// leaving out the commands that the current user lacks the permissions for.
+func fetchAppCommands(channel discord.Channel) ([]appCommand, error) {
+	url := api.EndpointChannels + channel.ID.String() + "/application-command-index"
+	if channel.GuildID.IsValid() {
+		url = api.EndpointGuilds + channel.GuildID.String() + "/application-command-index"
+	}
+
+	var index appCommandIndex
+	if err := discordState.RequestJSON(&index, "GET", url); err != nil {
+		return nil, err
+	}
+
+	var userIndex appCommandIndex
+	if err := discordState.RequestJSON(&userIndex, "GET", api.EndpointMe+"/application-command-index"); err != nil {
+		slog.Warn("failed to fetch application commands of the current user", "err", err)
+	}
+
+	appNames := make(map[discord.AppID]string)
+	for _, i := range []appCommandIndex{index, userIndex} {
+		for _, a := range i.Applications {
+			appNames[a.ID] = a.Name
+		}
+	}
+
+	var commands []appCommand
+	seen := make(map[discord.CommandID]struct{})
+	for _, c := range slices.Concat(index.Commands, userIndex.Commands) {
+		if _, ok := seen[c.ID]; ok || c.Type != discord.ChatInputCommand {
+			continue
+		}
+		seen[c.ID] = struct{}{}
+
+		if p := c.DefaultMemberPermissions; p != nil && channel.GuildID.IsValid() {
+			// Commands with no permissions are for administrators only.
+			perms := *p
+			if perms == 0 {
+				perms = discord.PermissionAdministrator
+			}
+			if !discordState.HasPermissions(channel.ID, perms) {
+				continue
+			}
+		}
+
+		c.appName = appNames[c.AppID]
+		commands = append(commands, c)
+	}
+
+	slices.SortFunc(commands, func(a, b appCommand) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.appName, b.appName))
+	})
+	return commands, nil
+}
+
+// findAppCommand returns the command with the name, or nil if there is none.
+func findAppCommand(commands []appCommand, name string) *appCommand {
+	i := slices.IndexFunc(commands, func(c appCommand) bool {
+		return c.Name == name
+	})
+	if i == -1 {
+		return nil
+	}
+	return &commands[i]
+}
+
+// resolveSubcommand follows the subcommand group and the subcommand named by
+// the arguments. It returns their names and the options of the subcommand, or
+// the subcommands that can follow if the arguments do not name one, along
+// with the number of arguments that name them.
+func resolveSubcommand(options discord.CommandOptions, args []string) (path []string, values []discord.CommandOptionValue, subcommands []discord.CommandOption, n int) {
+	for {
+		subcommands = slices.DeleteFunc(slices.Clone(options), func(o discord.CommandOption) bool {
+			return o.Type() != discord.SubcommandGroupOptionType && o.Type() != discord.SubcommandOptionType
+		})
+		if len(subcommands) == 0 {
+			for _, o := range options {
+				if v, ok := o.(discord.CommandOptionValue); ok {
+					values = append(values, v)
+				}
+			}
+			return path, values, nil, n
+		}
+
+		if n == len(args) {
+			return path, nil, subcommands, n
+		}
+
+		i := slices.IndexFunc(subcommands, func(o discord.CommandOption) bool {
+			return o.Name() == args[n]
+		})
+		if i == -1 {
+			return path, nil, subcommands, n
+		}
+
+		path = append(path, args[n])
+		n++
+
+		switch o := subcommands[i].(type) {
+		case *discord.SubcommandGroupOption:
+			options = make(discord.CommandOptions, len(o.Subcommands))
+			for i, s := range o.Subcommands {
+				options[i] = s
+			}
+		case *discord.SubcommandOption:
+			return path, o.Options, nil, n
+		}
+	}
+}
+
+// commandArgs returns the text that follows the first n words of the text.
+func commandArgs(text string, n int) string {
+	for range n {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		i := strings.IndexFunc(text, unicode.IsSpace)
+		if i == -1 {
+			return ""
+		}
+		text = text[i:]
+	}
+	return strings.TrimSpace(text)
+}
+
+// parseCommandArgs returns the values of the options that are assigned with
+// name:value in the text. The text that precedes the assignments is the value
+// of the first option, unless it is assigned too.
+func parseCommandArgs(text string, values []discord.CommandOptionValue) map[string]string {
+	type assignment struct {
+		name       string
+		start, end int
+	}
+
+	var assignments []assignment
+	for _, v := range values {
+		re := regexp.MustCompile(`(?:^|\s)(` + regexp.QuoteMeta(v.Name()) + `):`)
+		for _, m := range re.FindAllStringSubmatchIndex(text, -1) {
+			assignments = append(assignments, assignment{name: v.Name(), start: m[2], end: m[1]})
+		}
+	}
+
+	slices.SortFunc(assignments, func(a, b assignment) int {
+		return cmp.Compare(a.start, b.start)
+	})
+
+	args := make(map[string]string)
+	for i, a := range assignments {
+		end := len(text)
+		if i+1 < len(assignments) {
+			end = assignments[i+1].start
+		}
+		args[a.name] = strings.TrimSpace(text[a.end:end])
+	}
+
+	leading := text
+	if len(assignments) > 0 {
+		leading = text[:assignments[0].start]
+	}
+	if leading = strings.TrimSpace(leading); leading != "" && len(values) > 0 {
+		if _, ok := args[values[0].Name()]; !ok {
+			args[values[0].Name()] = leading
+		}
+	}
+
+	return args
+}
+
+// commandOptionData is an option of an invoked command, or its subcommand
+// group or subcommand with their options.
+type commandOptionData struct {
+	Type    discord.CommandOptionType `json:"type"`
+	Name    string                    `json:"name"`
+	Value   any                       `json:"value,omitempty"`
+	Options []commandOptionData       `json:"options,omitempty"`
+}
+
+// commandInvocation is a command with the values typed for its options.
+type commandInvocation struct {
+	channel discord.Channel
+	command appCommand
+	// path is the names of the subcommand group and subcommand.
+	path   []string
+	values []discord.CommandOptionValue
+	args   map[string]string
+}
+
+func (ci commandInvocation) String() string {
+	return "/" + strings.Join(append([]string{ci.command.Name}, ci.path...), " ")
+}
+
+// options validates the typed values and returns the options of the
+// interaction, and the paths of the files of the attachment options.
+func (ci commandInvocation) options() ([]commandOptionData, []string, error) {
+	var (
+		options = []commandOptionData{}
+		paths   []string
+	)
+
+	for _, v := range ci.values {
+		text := strings.TrimSpace(ci.args[v.Name()])
+		if text == "" {
+			if commandOptionRequired(v) {
+				return nil, nil, fmt.Errorf("%s is required", v.Name())
+			}
+			continue
+		}
+
+		value, err := parseCommandOptionValue(ci.channel, v, text)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", v.Name(), err)
+		}
+
+		// The value of an attachment option is the index of its file.
+		if v.Type() == discord.AttachmentOptionType {
+			paths = append(paths, text)
+			value = len(paths) - 1
+		}
+
+		options = append(options, commandOptionData{Type: v.Type(), Name: v.Name(), Value: value})
+	}
+
+	// The options are nested in the subcommand and its group.
+	for i := len(ci.path) - 1; i >= 0; i-- {
+		typ := discord.SubcommandOptionType
+		if i < len(ci.path)-1 {
+			typ = discord.SubcommandGroupOptionType
+		}
+		options = []commandOptionData{{Type: typ, Name: ci.path[i], Options: options}}
+	}
+
+	return options, paths, nil
+}
+
+func commandOptionRequired(v discord.CommandOptionValue) bool {
+	switch v := v.(type) {
+	case *discord.StringOption:
+		return v.Required
+	case *discord.IntegerOption:
+		return v.Required
+	case *discord.NumberOption:
+		return v.Required
+	case *discord.BooleanOption:
+		return v.Required
+	case *discord.UserOption:
+		return v.Required
+	case *discord.ChannelOption:
+		return v.Required
+	case *discord.RoleOption:
+		return v.Required
+	case *discord.MentionableOption:
+		return v.Required
+	case *discord.AttachmentOption:
+		return v.Required
+	}
+	return false
+}
+
+func commandOptionDescription(v discord.CommandOptionValue) string {
+	switch v := v.(type) {
+	case *discord.StringOption:
+		return v.Description
+	case *discord.IntegerOption:
+		return v.Description
+	case *discord.NumberOption:
+		return v.Description
+	case *discord.BooleanOption:
+		return v.Description
+	case *discord.UserOption:
+		return v.Description
+	case *discord.ChannelOption:
+		return v.Description
+	case *discord.RoleOption:
+		return v.Description
+	case *discord.MentionableOption:
+		return v.Description
+	case *discord.AttachmentOption:
+		return v.Description
+	}
+	return ""
+}
+
+// commandOptionChoice is a choice of the value of an option.
+type commandOptionChoice struct {
+	name  string
+	value any
+}
+
+func commandOptionChoices(v discord.CommandOptionValue) []commandOptionChoice {
+	var choices []commandOptionChoice
+	switch v := v.(type) {
+	case *discord.StringOption:
+		for _, c := range v.Choices {
+			choices = append(choices, commandOptionChoice{c.Name, c.Value})
+		}
+	case *discord.IntegerOption:
+		for _, c := range v.Choices {
+			choices = append(choices, commandOptionChoice{c.Name, c.Value})
+		}
+	case *discord.NumberOption:
+		for _, c := range v.Choices {
+			choices = append(choices, commandOptionChoice{c.Name, c.Value})
+		}
+	}
+	return choices
+}
+
+var (
+	userMentionRegex    = regexp.MustCompile(`^<@!?(\d+)>$`)
+	roleMentionRegex    = regexp.MustCompile(`^<@&(\d+)>$`)
+	channelMentionRegex = regexp.MustCompile(`^<#(\d+)>$`)
+)
+
+// parseCommandOptionValue validates the text typed for the option and
+// returns its value. Users, roles and channels are looked up by name, mention
+// or ID, and attachments are paths to files.
+func parseCommandOptionValue(channel discord.Channel, v discord.CommandOptionValue, text string) (any, error) {
+	if choices := commandOptionChoices(v); len(choices) > 0 {
+		for _, c := range choices {
+			if strings.EqualFold(c.name, text) || fmt.Sprint(c.value) == text {
+				return c.value, nil
+			}
+		}
+		return nil, errors.New("not one of the choices")
+	}
+
+	switch v := v.(type) {
+	case *discord.StringOption:
+		n := utf8.RuneCountInString(text)
+		if v.MinLength != nil && n < *v.MinLength {
+			return nil, fmt.Errorf("must be at least %d characters long", *v.MinLength)
+		}
+		if v.MaxLength != nil && n > *v.MaxLength {
+			return nil, fmt.Errorf("must be at most %d characters long", *v.MaxLength)
+		}
+		return text, nil
+
+	case *discord.IntegerOption:
+		i, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, errors.New("not an integer")
+		}
+		if v.Min != nil && i < *v.Min {
+			return nil, fmt.Errorf("must be at least %d", *v.Min)
+		}
+		if v.Max != nil && i > *v.Max {
+			return nil, fmt.Errorf("must be at most %d", *v.Max)
+		}
+		return i, nil
+
+	case *discord.NumberOption:
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, errors.New("not a number")
+		}
+		if v.Min != nil && f < *v.Min {
+			return nil, fmt.Errorf("must be at least %g", *v.Min)
+		}
+		if v.Max != nil && f > *v.Max {
+			return nil, fmt.Errorf("must be at most %g", *v.Max)
+		}
+		return f, nil
+
+	case *discord.BooleanOption:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, errors.New("not true or false")
+		}
+		return b, nil
+
+	case *discord.UserOption:
+		id, ok := lookupUser(channel, text)
+		if !ok {
+			return nil, errors.New("unknown user")
+		}
+		return id.String(), nil
+
+	case *discord.RoleOption:
+		id, ok := lookupRole(channel, text)
+		if !ok {
+			return nil, errors.New("unknown role")
+		}
+		return id.String(), nil
+
+	case *discord.MentionableOption:
+		if id, ok := lookupUser(channel, text); ok {
+			return id.String(), nil
+		}
+		if id, ok := lookupRole(channel, text); ok {
+			return id.String(), nil
+		}
+		return nil, errors.New("unknown user or role")
+
+	case *discord.ChannelOption:
+		c, ok := lookupChannel(channel, text)
+		if !ok {
+			return nil, errors.New("unknown channel")
+		}
+		if len(v.ChannelTypes) > 0 && !slices.Contains(v.ChannelTypes, c.Type) {
+			return nil, errors.New("channel of the wrong type")
+		}
+		return c.ID.String(), nil
+
+	case *discord.AttachmentOption:
+		info, err := os.Stat(text)
+		if err != nil {
+			return nil, errors.New("file not found")
+		}
+		if info.IsDir() {
+			return nil, errors.New("not a file")
+		}
+		return nil, nil
+	}
+
+	return nil, errors.New("unsupported option type")
+}
+
+// mentionedID returns the ID of the mention, or the ID itself.
+func mentionedID(re *regexp.Regexp, text string) (discord.Snowflake, bool) {
+	if m := re.FindStringSubmatch(text); m != nil {
+		text = m[1]
+	}
+
+	id, err := discord.ParseSnowflake(text)
+	return id, err == nil && id.IsValid()
+}
+
+func lookupUser(channel discord.Channel, text string) (discord.UserID, bool) {
+	if id, ok := mentionedID(userMentionRegex, text); ok {
+		return discord.UserID(id), true
+	}
+
+	name := strings.TrimPrefix(text, "@")
+	matches := func(u discord.User) bool {
+		return strings.EqualFold(u.Username, name) || strings.EqualFold(u.DisplayName, name)
+	}
+
+	if !channel.GuildID.IsValid() {
+		users := channel.DMRecipients
+		if me, err := discordState.Cabinet.Me(); err == nil {
+			users = append(users, *me)
+		}
+		if i := slices.IndexFunc(users, matches); i != -1 {
+			return users[i].ID, true
+		}
+		return 0, false
+	}
+
+	var id discord.UserID
+	discordState.MemberStore.Each(channel.GuildID, func(m *discord.Member) bool {
+		if matches(m.User) || strings.EqualFold(m.Nick, name) {
+			id = m.User.ID
+			return true
+		}
+		return false
+	})
+	return id, id.IsValid()
+}
+
+func lookupRole(channel discord.Channel, text string) (discord.RoleID, bool) {
+	if id, ok := mentionedID(roleMentionRegex, text); ok {
+		return discord.RoleID(id), true
+	}
+
+	roles, err := discordState.Cabinet.Roles(channel.GuildID)
+	if err != nil {
+		return 0, false
+	}
+
+	name := strings.TrimPrefix(text, "@")
+	i := slices.IndexFunc(roles, func(r discord.Role) bool {
+		return strings.EqualFold(r.Name, name)
+	})
+	if i == -1 {
+		return 0, false
+	}
+	return roles[i].ID, true
+}
+
+func lookupChannel(channel discord.Channel, text string) (*discord.Channel, bool) {
+	if id, ok := mentionedID(channelMentionRegex, text); ok {
+		c, err := discordState.Cabinet.Channel(discord.ChannelID(id))
+		return c, err == nil
+	}
+
+	channels, err := discordState.Cabinet.Channels(channel.GuildID)
+	if err != nil {
+		return nil, false
+	}
+
+	name := strings.TrimPrefix(text, "#")
+	i := slices.IndexFunc(channels, func(c discord.Channel) bool {
+		return strings.EqualFold(c.Name, name)
+	})
+	if i == -1 {
+		return nil, false
+	}
+	return &channels[i], true
+}
+
+type commandInteractionData struct {
+	Version            discord.Snowflake   `json:"version"`
+	ID                 discord.CommandID   `json:"id"`
+	Name               string              `json:"name"`
+	Type               discord.CommandType `json:"type"`
+	Options            []commandOptionData `json:"options"`
+	ApplicationCommand json.RawMessage     `json:"application_command"`
+	Attachments        []commandAttachment `json:"attachments"`
+}
+
+type commandAttachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+}
+
+// invoke sends the interaction that invokes the command. Its response is
+// sent as a message through the gateway, and onDone is called once the
+// application responded or did not.
+func (ci commandInvocation) invoke(onDone func(error)) error {
+	options, paths, err := ci.options()
+	if err != nil {
+		return err
+	}
+
+	data := commandInteractionData{
+		Version:            ci.command.Version,
+		ID:                 ci.command.ID,
+		Name:               ci.command.Name,
+		Type:               ci.command.Type,
+		Options:            options,
+		ApplicationCommand: ci.command.raw,
+		Attachments:        []commandAttachment{},
+	}
+
+	var files []sendpart.File
+	for i, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		name := filepath.Base(path)
+		files = append(files, sendpart.File{Name: name, Reader: f})
+		data.Attachments = append(data.Attachments, commandAttachment{ID: strconv.Itoa(i), Filename: name})
+	}
+
+	return app.interactions.send(interaction{
+		Type:          discord.CommandInteractionType,
+		ApplicationID: ci.command.AppID,
+		GuildID:       ci.channel.GuildID,
+		ChannelID:     ci.channel.ID,
+		Data:          data,
+		files:         files,
+	}, onDone)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+func TestParseCommandArgs(t *testing.T) {
+	values := []discord.CommandOptionValue{
+		&discord.StringOption{OptionName: "query"},
+		&discord.IntegerOption{OptionName: "count"},
+		&discord.BooleanOption{OptionName: "ephemeral"},
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want map[string]string
+	}{
+		{"empty", "", map[string]string{}},
+		{"leading text", "cats and dogs", map[string]string{"query": "cats and dogs"}},
+		{"assignment", "count:5", map[string]string{"count": "5"}},
+		{"several assignments", "query:cats count: 5 ephemeral:true", map[string]string{"query": "cats", "count": "5", "ephemeral": "true"}},
+		{"leading text and assignments", "cats count:5", map[string]string{"query": "cats", "count": "5"}},
+		{"assigned first option wins", "dogs query:cats", map[string]string{"query": "cats"}},
+		{"value with spaces", "query:cats and dogs count:2", map[string]string{"query": "cats and dogs", "count": "2"}},
+		{"name inside word", "recount:5", map[string]string{"query": "recount:5"}},
+		{"unknown option", "limit:5", map[string]string{"query": "limit:5"}},
+		{"later assignment wins", "count:1 count:2", map[string]string{"count": "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseCommandArgs(tt.text, values); !maps.Equal(got, tt.want) {
+				t.Errorf("parseCommandArgs(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCommandArgsNoOptions(t *testing.T) {
+	if got := parseCommandArgs("text", nil); len(got) != 0 {
+		t.Errorf("parseCommandArgs() = %v, want no arguments", got)
+	}
+}
@@ -1,28 +1,49 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	stdhttp "net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/ayn2op/discordo/internal/clipboard"
 	"github.com/ayn2op/discordo/internal/config"
 	"github.com/ayn2op/discordo/internal/consts"
+	"github.com/ayn2op/discordo/internal/http"
 	"github.com/ayn2op/discordo/internal/login"
+	"github.com/ayn2op/discordo/internal/media"
 	"github.com/ayn2op/tview"
 	"github.com/gdamore/tcell/v3"
 )
 
 type application struct {
 	*tview.Application
-	chatView *chatView
-	cfg      *config.Config
+	chatView     *chatView
+	outbox       *outbox
+	polls        *pollStore
+	commands     *appCommandStore
+	interactions *interactionStore
+	recentEmoji  *recentEmoji
+	drafts       *drafts
+	media        *media.Cache
+	cfg          *config.Config
+
+	closeOnce sync.Once
 }
 
 func newApplication(cfg *config.Config) *application {
 	tview.Styles = tview.Theme{}
 	app := &application{
-		Application: tview.NewApplication(),
-		cfg:         cfg,
+		Application:  tview.NewApplication(),
+		polls:        newPollStore(),
+		commands:     newAppCommandStore(),
+		interactions: newInteractionStore(),
+		cfg:          cfg,
 	}
 
 	if err := clipboard.Init(); err != nil {
@@ -60,26 +81,95 @@ func (a *application) run(token string) error {
 		})
 		a.SetRoot(loginForm)
 	} else {
+		if a.media == nil {
+			if a.media, err = newMediaCache(a.cfg); err != nil {
+				return err
+			}
+		}
+
 		a.chatView = newChatView(a.Application, a.cfg)
-		if err := openState(token); err != nil {
-			return err
+		if a.cfg.ImagePreviews.Enabled {
+			a.chatView.messagesList.previews = newImagePreviews(a.cfg, screen)
+		}
+		a.outbox = newOutbox(filepath.Join(consts.CacheDir(), "outbox.json"))
+		a.recentEmoji = newRecentEmoji(filepath.Join(consts.CacheDir(), "recent_emoji.json"))
+		a.drafts = newDrafts(filepath.Join(consts.CacheDir(), "drafts.json"))
+		newState(token)
+		a.chatView.statusBar.draw()
+
+		if offline {
+			if err := openOffline(); err != nil {
+				return err
+			}
+		} else if err := discordState.Open(context.TODO()); err != nil {
+			// Fall back to the cached state if there is one.
+			if discordStore == nil {
+				return err
+			}
+
+			slog.Error("failed to connect to the gateway; starting offline", "err", err)
+			if offlineErr := openOffline(); offlineErr != nil {
+				return errors.Join(err, offlineErr)
+			}
 		}
+		go a.outbox.run()
 		a.SetRoot(a.chatView)
 	}
 
-	return a.Run()
+	err = a.Run()
+	a.close()
+	return err
 }
 
-func (a *application) quit() {
-	if discordState != nil {
-		if err := discordState.Close(); err != nil {
-			slog.Error("failed to close the session", "err", err)
+// newMediaCache opens the cache of downloaded media. The directories that
+// avatars and attachments were downloaded to before are removed if they are
+// still there, as their files were never evicted.
+func newMediaCache(cfg *config.Config) (*media.Cache, error) {
+	for _, name := range []string{"avatars", "attachments"} {
+		dir := filepath.Join(consts.CacheDir(), name)
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+
+		slog.Info("removing old media dir", "dir", dir)
+		if err := os.RemoveAll(dir); err != nil {
+			slog.Warn("failed to remove old media dir", "err", err, "dir", dir)
 		}
 	}
 
+	client := &stdhttp.Client{Transport: http.NewTransport()}
+	return media.New(filepath.Join(consts.CacheDir(), "media"), client, media.Options{
+		MaxAge:  time.Duration(cfg.Cache.MediaMaxAgeDays) * 24 * time.Hour,
+		MaxSize: int64(cfg.Cache.MediaMaxSizeMB) << 20,
+	})
+}
+
+func (a *application) quit() {
 	a.Stop()
 }
 
+// close saves the draft and closes the session and the store once the
+// application has stopped, however it was stopped.
+func (a *application) close() {
+	a.closeOnce.Do(func() {
+		if a.chatView != nil {
+			a.chatView.messageInput.saveDraft()
+		}
+
+		if discordState != nil {
+			if err := discordState.Close(); err != nil {
+				slog.Error("failed to close the session", "err", err)
+			}
+		}
+
+		if discordStore != nil {
+			if err := discordStore.Close(); err != nil {
+				slog.Error("failed to close the store", "err", err)
+			}
+		}
+	})
+}
+
 func (a *application) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Name() {
 	case a.cfg.Keys.Quit:
@@ -3,12 +3,14 @@ package cmd
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/ayn2op/discordo/internal/config"
 	"github.com/ayn2op/discordo/internal/keyring"
 	"github.com/ayn2op/discordo/internal/ui"
 	"github.com/ayn2op/tview"
 	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/gateway"
 	"github.com/gdamore/tcell/v3"
 )
 
@@ -19,13 +21,24 @@ const (
 	confirmModalPageName    = "confirmModal"
 	friendsListPageName     = "friendsList"
 	reactionPickerPageName  = "reactionPicker"
+	reactorsListPageName    = "reactorsList"
+	forwardPickerPageName   = "forwardPicker"
 	joinServerPageName      = "joinServer"
 	pinnedMessagesPageName  = "pinnedMessages"
+	createThreadPageName    = "createThread"
+	searchPageName          = "search"
+	pollVotePageName        = "pollVote"
+	createPollPageName      = "createPoll"
+	exportPageName          = "export"
+	commandOptionsPageName  = "commandOptions"
+	componentSelectPageName = "componentSelect"
+	modalPageName           = "modal"
 )
 
 type chatView struct {
 	*tview.Pages
 
+	rootFlex  *tview.Flex
 	mainFlex  *tview.Flex
 	rightFlex *tview.Flex
 
@@ -33,6 +46,17 @@ type chatView struct {
 	messagesList *messagesList
 	messageInput *messageInput
 	membersList  *membersList
+	searchView   *searchView
+	statusBar    *statusBar
+
+	typingIndicator *typingIndicator
+
+	// connectionBanner is shown above the messages list while offline, that
+	// is, while the cached state is shown before the gateway is connected, and
+	// while reconnecting after the connection was lost.
+	connectionBanner *tview.TextView
+	offline          bool
+	reconnecting     bool
 
 	selectedChannel *discord.Channel
 
@@ -44,6 +68,7 @@ func newChatView(app *tview.Application, cfg *config.Config) *chatView {
 	chatView := &chatView{
 		Pages: tview.NewPages(),
 
+		rootFlex:  tview.NewFlex(),
 		mainFlex:  tview.NewFlex(),
 		rightFlex: tview.NewFlex(),
 
@@ -51,6 +76,12 @@ func newChatView(app *tview.Application, cfg *config.Config) *chatView {
 		messagesList: newMessagesList(cfg),
 		messageInput: newMessageInput(cfg),
 		membersList:  newMembersList(cfg),
+		searchView:   newSearchView(cfg),
+		statusBar:    newStatusBar(cfg),
+
+		typingIndicator: newTypingIndicator(cfg),
+
+		connectionBanner: tview.NewTextView(),
 
 		app: app,
 		cfg: cfg,
@@ -58,19 +89,27 @@ func newChatView(app *tview.Application, cfg *config.Config) *chatView {
 
 	chatView.SetInputCapture(chatView.onInputCapture)
 
+	chatView.connectionBanner.
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignmentCenter)
+
 	chatView.buildLayout()
 	return chatView
 }
 
+// Draw draws the pages and then the image previews of the messages list, which
+// are drawn over what is on the screen.
+func (cv *chatView) Draw(screen tcell.Screen) {
+	cv.Pages.Draw(screen)
+	cv.messagesList.drawImagePreviews(screen)
+}
+
 func (cv *chatView) buildLayout() {
 	cv.Clear()
 	cv.rightFlex.Clear()
 	cv.mainFlex.Clear()
 
-	cv.rightFlex.
-		SetDirection(tview.FlexRow).
-		AddItem(cv.messagesList, 0, 1, false).
-		AddItem(cv.messageInput, 3, 1, false)
+	cv.buildRightFlex()
 
 	// Build layout based on membersList visibility
 	if cv.membersList.visible {
@@ -86,7 +125,282 @@ func (cv *chatView) buildLayout() {
 			AddItem(cv.rightFlex, 0, 4, false)
 	}
 
-	cv.AddAndSwitchToPage(flexPageName, cv.mainFlex, true)
+	// The status bar spans the whole width below the columns.
+	cv.rootFlex.Clear().SetDirection(tview.FlexRow).AddItem(cv.mainFlex, 0, 1, true)
+	if cv.cfg.ShowStatusBar {
+		cv.rootFlex.AddItem(cv.statusBar, 1, 0, false)
+	}
+
+	cv.AddAndSwitchToPage(flexPageName, cv.rootFlex, true)
+}
+
+func (cv *chatView) buildRightFlex() {
+	cv.rightFlex.Clear().SetDirection(tview.FlexRow)
+	switch {
+	case cv.offline:
+		cv.connectionBanner.SetText("[::b]Offline[::B] - showing cached messages until the connection is restored")
+		cv.rightFlex.AddItem(cv.connectionBanner, 1, 0, false)
+	case cv.reconnecting:
+		cv.connectionBanner.SetText("[::b]Reconnecting[::B] - the connection to Discord was lost")
+		cv.rightFlex.AddItem(cv.connectionBanner, 1, 0, false)
+	}
+
+	cv.rightFlex.
+		AddItem(cv.messagesList, 0, 1, false).
+		AddItem(cv.typingIndicator, 1, 0, false).
+		AddItem(cv.messageInput, 3, 1, false)
+}
+
+// setOffline shows or hides the offline banner. While offline, the message
+// input is disabled.
+func (cv *chatView) setOffline(offline bool) {
+	if cv.offline == offline {
+		return
+	}
+
+	cv.offline = offline
+	cv.buildRightFlex()
+
+	if cv.selectedChannel != nil {
+		cv.setChannel(cv.selectedChannel)
+	} else if offline {
+		cv.messageInput.SetPlaceholder("Offline - messages cannot be sent until the connection is restored.")
+	}
+}
+
+// setReconnecting shows or hides the banner that tells that the gateway is
+// reconnecting.
+func (cv *chatView) setReconnecting(reconnecting bool) {
+	if cv.reconnecting == reconnecting {
+		return
+	}
+
+	cv.reconnecting = reconnecting
+	cv.buildRightFlex()
+}
+
+// resync reconciles the UI with the state received on READY after a
+// reconnection: the guilds tree is rebuilt and the messages created in the
+// selected channel while disconnected are fetched.
+func (cv *chatView) resync(folders []gateway.GuildFolder) {
+	cv.setReconnecting(false)
+	cv.guildsTree.resync(folders)
+
+	if channel := cv.selectedChannel; channel != nil {
+		// The channel may have been updated while disconnected.
+		if c, err := discordState.Cabinet.Channel(channel.ID); err == nil {
+			cv.selectedChannel = c
+			cv.messagesList.setTitle(*c)
+		}
+
+		cv.messagesList.backfill()
+	}
+}
+
+// openChannel selects the channel and loads its latest messages into the
+// messages list.
+func (cv *chatView) openChannel(channel *discord.Channel) {
+	// The read state is acknowledged once the messages are fetched, so the
+	// last read message is remembered first.
+	var lastReadID discord.MessageID
+	if rs := discordState.ReadState.ReadState(channel.ID); rs != nil {
+		lastReadID = rs.LastMessageID
+	}
+	cv.messagesList.setLastRead(channel.ID, lastReadID)
+
+	// Only the cached messages can be shown while offline.
+	if cv.offline {
+		messages, err := discordState.Cabinet.Messages(channel.ID)
+		if err != nil {
+			slog.Error("failed to get cached messages", "err", err, "channel_id", channel.ID)
+		}
+
+		if limit := int(cv.cfg.MessagesLimit); limit > 0 && len(messages) > limit {
+			messages = messages[:limit]
+		}
+
+		cv.showChannel(channel, messages)
+		return
+	}
+
+	// Do everything async to avoid blocking the UI thread
+	go func() {
+		// Show the messages persisted by a previous session while the latest
+		// ones are fetched.
+		var shownPersisted bool
+		if discordStore != nil && discordStore.Persisted(channel.ID) {
+			if cached, err := discordState.Cabinet.Messages(channel.ID); err == nil {
+				if limit := int(cv.cfg.MessagesLimit); limit > 0 && len(cached) > limit {
+					cached = cached[:limit]
+				}
+
+				shownPersisted = true
+				cv.app.QueueUpdateDraw(func() {
+					cv.showChannel(channel, cached)
+				})
+			}
+
+			if err := refreshPersistedMessages(channel); err != nil {
+				slog.Error("failed to refresh persisted messages", "err", err, "channel_id", channel.ID)
+			}
+		}
+
+		slog.Info("fetching messages", "channel_id", channel.ID, "limit", cv.cfg.MessagesLimit)
+		messages, err := discordState.Messages(channel.ID, uint(cv.cfg.MessagesLimit))
+		if err != nil {
+			slog.Error("failed to get messages", "err", err, "channel_id", channel.ID, "limit", cv.cfg.MessagesLimit)
+			return
+		}
+		slog.Info("messages fetched", "channel_id", channel.ID, "count", len(messages))
+
+		// Mark channel as read with the actual latest message ID from fetched messages
+		if len(messages) > 0 {
+			latestMessageID := messages[0].ID
+			slog.Debug("marking channel as read", "channel_id", channel.ID, "latest_message_id", latestMessageID)
+			discordState.ReadState.MarkRead(channel.ID, latestMessageID)
+		}
+
+		if guildID := channel.GuildID; guildID.IsValid() {
+			cv.messagesList.requestGuildMembers(guildID, messages)
+		}
+
+		// All UI updates must be on UI thread
+		cv.app.QueueUpdateDraw(func() {
+			slog.Info("drawing messages", "channel_id", channel.ID, "count", len(messages))
+
+			// The channel is already shown with the persisted messages;
+			// replace them unless another channel was selected meanwhile.
+			if shownPersisted {
+				if cv.selectedChannel != nil && cv.selectedChannel.ID == channel.ID {
+					cv.messagesList.setMessages(messages)
+				}
+				return
+			}
+
+			cv.showChannel(channel, messages)
+		})
+	}()
+}
+
+// showChannel selects the channel and shows the messages, ordered from latest
+// to oldest.
+func (cv *chatView) showChannel(channel *discord.Channel, messages []discord.Message) {
+	canSend := cv.setChannel(channel)
+	cv.messagesList.setMessages(messages)
+	if !cv.messagesList.selectFirstUnread() {
+		cv.messagesList.ScrollToEnd()
+	}
+
+	if canSend && cv.cfg.AutoFocus {
+		cv.app.SetFocus(cv.messageInput)
+	}
+}
+
+// jumpToMessage selects the message in the messages list, switching to its
+// channel and loading the messages around it if it is not loaded. onDone, if
+// not nil, is called from the UI thread once the message is selected or with
+// the error that prevented it.
+func (cv *chatView) jumpToMessage(channelID discord.ChannelID, messageID discord.MessageID, onDone func(error)) {
+	if cv.selectedChannel != nil && cv.selectedChannel.ID == channelID && cv.messagesList.messageIndex(messageID) != -1 {
+		cv.messagesList.selectMessage(messageID)
+		cv.app.SetFocus(cv.messagesList)
+		if onDone != nil {
+			onDone(nil)
+		}
+		return
+	}
+
+	go cv.loadMessagesAround(channelID, messageID, onDone)
+}
+
+// loadMessagesAround selects the channel of the message, loads the messages
+// around it and selects it in the messages list. onDone is called like for
+// jumpToMessage. It must not be called from the UI thread.
+func (cv *chatView) loadMessagesAround(channelID discord.ChannelID, messageID discord.MessageID, onDone func(error)) {
+	done := func(err error) {
+		if onDone != nil {
+			cv.app.QueueUpdateDraw(func() {
+				onDone(err)
+			})
+		}
+	}
+
+	channel, err := discordState.Channel(channelID)
+	if err != nil {
+		slog.Error("failed to get channel", "err", err, "channel_id", channelID)
+		done(err)
+		return
+	}
+
+	slog.Info("fetching messages around message", "channel_id", channelID, "message_id", messageID)
+	messages, err := discordState.MessagesAround(channelID, messageID, uint(cv.cfg.MessagesLimit))
+	if err != nil {
+		slog.Error("failed to get messages around message", "err", err, "channel_id", channelID, "message_id", messageID)
+		done(err)
+		return
+	}
+
+	// Messages fetched from the API do not have the guild ID set.
+	for i := range messages {
+		messages[i].GuildID = channel.GuildID
+	}
+
+	if guildID := channel.GuildID; guildID.IsValid() {
+		cv.messagesList.requestGuildMembers(guildID, messages)
+	}
+
+	cv.app.QueueUpdateDraw(func() {
+		cv.setChannel(channel)
+		cv.messagesList.setMessagesAround(messages, messageID, channel.LastMessageID)
+		cv.app.SetFocus(cv.messagesList)
+		if onDone != nil {
+			onDone(nil)
+		}
+	})
+}
+
+// setChannel makes the channel the selected one: it is selected in the guilds
+// tree if it is listed there, the messages list is emptied and the message
+// input is enabled if the current user can send messages in the channel,
+// which is reported back.
+func (cv *chatView) setChannel(channel *discord.Channel) bool {
+	cv.messageInput.switchChannel(cv.selectedChannel, channel)
+	cv.selectedChannel = channel
+	cv.messagesList.reset()
+	cv.messagesList.setTitle(*channel)
+
+	cv.guildsTree.selectChannel(*channel)
+	cv.typingIndicator.draw()
+	cv.messageInput.lastTyping = time.Time{}
+
+	canSend := canSendMessages(*channel)
+	switch {
+	case cv.offline:
+		canSend = false
+		cv.messageInput.SetPlaceholder("Offline - messages cannot be sent until the connection is restored.")
+	case canSend:
+		cv.messageInput.SetPlaceholder("Message...")
+	default:
+		cv.messageInput.SetPlaceholder("You do not have permission to send messages in this channel.")
+	}
+	cv.messageInput.SetDisabled(!canSend)
+
+	return canSend
+}
+
+// canSendMessages reports whether the current user can send messages in the
+// channel.
+func canSendMessages(channel discord.Channel) bool {
+	if channel.Type == discord.DirectMessage || channel.Type == discord.GroupDM {
+		return true
+	}
+
+	sendPermission := discord.PermissionSendMessages
+	if isThread(channel.Type) {
+		sendPermission = discord.PermissionSendMessagesInThreads
+	}
+
+	return discordState.HasPermissions(channel.ID, sendPermission)
 }
 
 func (cv *chatView) toggleGuildsTree() {
@@ -239,6 +553,9 @@ func (cv *chatView) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
 	case cv.cfg.Keys.ShowPinnedMessages:
 		cv.showPinnedMessages()
 		return nil
+	case cv.cfg.Keys.SearchMessages:
+		cv.showSearch()
+		return nil
 	}
 
 	return event
@@ -442,6 +759,10 @@ func (cv *chatView) showPinnedMessageDetail(msg discord.Message, previousFocus t
 			cv.RemovePage(pinnedMessagesPageName).SwitchToPage(flexPageName)
 			cv.app.SetFocus(previousFocus)
 			return nil
+		case "Enter":
+			cv.RemovePage(pinnedMessagesPageName).SwitchToPage(flexPageName)
+			cv.jumpToMessage(msg.ChannelID, msg.ID, nil)
+			return nil
 		case "Rune[u]", "Rune[U]":
 			// Unpin this message
 			cv.RemovePage(pinnedMessagesPageName).SwitchToPage(flexPageName)
@@ -453,12 +774,46 @@ func (cv *chatView) showPinnedMessageDetail(msg discord.Message, previousFocus t
 	})
 
 	textView.Box = ui.ConfigureBox(textView.Box, &cv.cfg.Theme)
-	textView.SetTitle("Pinned Message (Press U to unpin, Esc to close)")
+	textView.SetTitle("Pinned Message (Press Enter to jump, U to unpin, Esc to close)")
 
 	cv.AddAndSwitchToPage(pinnedMessagesPageName, ui.Centered(textView, 80, 20), true).
 		ShowPage(flexPageName)
 }
 
+func (cv *chatView) showSearch() {
+	if cv.selectedChannel == nil {
+		return
+	}
+
+	previousFocus := cv.app.GetFocus()
+	closeSearch := func() {
+		cv.RemovePage(searchPageName).SwitchToPage(flexPageName)
+		cv.app.SetFocus(previousFocus)
+	}
+
+	sv := cv.searchView
+	sv.setScope(*cv.selectedChannel)
+	sv.onDone = closeSearch
+	// The search stays open until the message is jumped to, so that another
+	// result can be selected if it fails.
+	sv.onSelected = func(message discord.Message) {
+		cv.jumpToMessage(message.ChannelID, message.ID, func(err error) {
+			if err != nil {
+				cv.statusBar.setTaskResult(fmt.Sprintf("[red]failed to jump to message: %s[-]", tview.Escape(err.Error())))
+				cv.app.SetFocus(sv.list)
+				return
+			}
+
+			cv.RemovePage(searchPageName).SwitchToPage(flexPageName)
+			cv.app.SetFocus(cv.messagesList)
+		})
+	}
+
+	cv.AddAndSwitchToPage(searchPageName, ui.Centered(sv, 100, 30), true).
+		ShowPage(flexPageName)
+	cv.app.SetFocus(sv.input)
+}
+
 func (cv *chatView) unpinMessageByID(channelID discord.ChannelID, messageID discord.MessageID) {
 	slog.Info("unpinning message", "channel_id", channelID, "message_id", messageID)
 
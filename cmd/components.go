@@ -0,0 +1,626 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ayn2op/discordo/internal/ui"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/gdamore/tcell/v3"
+)
+
+// labelComponentType is the type of the components of modals that wrap
+// another component with a label, which are not known to arikawa.
+const labelComponentType discord.ComponentType = 18
+
+// componentState is the state of a component that was pressed.
+type componentState int
+
+const (
+	componentLoading componentState = iota + 1
+	componentFailed
+)
+
+type componentKey struct {
+	messageID discord.MessageID
+	customID  discord.ComponentID
+}
+
+// componentInteractionData is the data of the interaction that presses a
+// button.
+type componentInteractionData struct {
+	ComponentType discord.ComponentType `json:"component_type"`
+	CustomID      discord.ComponentID   `json:"custom_id"`
+}
+
+// selectInteractionData is the data of the interaction that picks the values
+// of a select menu.
+type selectInteractionData struct {
+	componentInteractionData
+	Type   discord.ComponentType `json:"type"`
+	Values []string              `json:"values"`
+}
+
+// messageComponents returns the interactive components of the message in the
+// order that they are drawn.
+func messageComponents(message discord.Message) []discord.InteractiveComponent {
+	var components []discord.InteractiveComponent
+	for _, c := range message.Components {
+		if row, ok := c.(*discord.ActionRowComponent); ok {
+			components = append(components, *row...)
+		}
+	}
+
+	return components
+}
+
+// linkButtonURL returns the URL of the button if it is a link button. arikawa
+// does not export the style that holds it, but marshals it.
+func linkButtonURL(button *discord.ButtonComponent) (string, bool) {
+	b, err := json.Marshal(button)
+	if err != nil {
+		return "", false
+	}
+
+	var link struct {
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(b, &link); err != nil {
+		return "", false
+	}
+
+	return link.URL, link.URL != ""
+}
+
+// selectValueLimits returns the minimum and maximum number of values of a
+// select menu, which are one if they are not set.
+func selectValueLimits(limits [2]int) (int, int) {
+	if limits == [2]int{} {
+		return 1, 1
+	}
+
+	return limits[0], limits[1]
+}
+
+func valueLimitsText(minValues, maxValues int) string {
+	if minValues == maxValues {
+		return fmt.Sprintf("Select %d", minValues)
+	}
+
+	return fmt.Sprintf("Select %d to %d", minValues, maxValues)
+}
+
+// focusComponents focuses the first component of the selected message.
+func (ml *messagesList) focusComponents() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	if len(messageComponents(*msg)) == 0 {
+		return
+	}
+
+	ml.focusedComponent = 0
+	ml.redrawMessage(msg.ID)
+}
+
+func (ml *messagesList) unfocusComponents() {
+	ml.focusedComponent = -1
+	ml.redrawMessage(ml.selectedMessageID)
+}
+
+// onComponentsInputCapture handles the keys while the components of the
+// selected message are focused. Other keys stop focusing them and are
+// returned to be handled as usual.
+func (ml *messagesList) onComponentsInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		ml.focusedComponent = -1
+		return event
+	}
+
+	// The components may have changed since they were focused.
+	components := messageComponents(*msg)
+	if len(components) == 0 {
+		ml.unfocusComponents()
+		return event
+	}
+	ml.focusedComponent = min(ml.focusedComponent, len(components)-1)
+
+	switch event.Name() {
+	case ml.cfg.Keys.MessagesList.NextComponent:
+		ml.focusedComponent = (ml.focusedComponent + 1) % len(components)
+	case ml.cfg.Keys.MessagesList.PreviousComponent:
+		ml.focusedComponent = (ml.focusedComponent - 1 + len(components)) % len(components)
+	case ml.cfg.Keys.MessagesList.PressComponent:
+		ml.pressComponent(*msg, components[ml.focusedComponent])
+		return nil
+	case ml.cfg.Keys.MessagesList.Cancel:
+		ml.unfocusComponents()
+		return nil
+	default:
+		ml.unfocusComponents()
+		return event
+	}
+
+	ml.redrawMessage(msg.ID)
+	return nil
+}
+
+// pressComponent presses the component of the message. Link buttons open
+// their URL, other buttons send an interaction right away, and select menus
+// ask for the values to send first.
+func (ml *messagesList) pressComponent(message discord.Message, component discord.InteractiveComponent) {
+	switch c := component.(type) {
+	case *discord.ButtonComponent:
+		if c.Disabled {
+			return
+		}
+
+		if url, ok := linkButtonURL(c); ok {
+			go ml.openURL(url)
+			return
+		}
+
+		go ml.sendComponentInteraction(message, c.CustomID, componentInteractionData{
+			ComponentType: discord.ButtonComponentType,
+			CustomID:      c.CustomID,
+		})
+	case *discord.StringSelectComponent:
+		if !c.Disabled {
+			ml.showStringSelect(message, c)
+		}
+	case *discord.UserSelectComponent:
+		if !c.Disabled {
+			ml.showEntitySelect(message, c, selectLabel(c.Placeholder, "Select a user", nil), c.ValueLimits)
+		}
+	case *discord.RoleSelectComponent:
+		if !c.Disabled {
+			ml.showEntitySelect(message, c, selectLabel(c.Placeholder, "Select a role", nil), c.ValueLimits)
+		}
+	case *discord.MentionableSelectComponent:
+		if !c.Disabled {
+			ml.showEntitySelect(message, c, selectLabel(c.Placeholder, "Select a user or role", nil), c.ValueLimits)
+		}
+	case *discord.ChannelSelectComponent:
+		if !c.Disabled {
+			ml.showEntitySelect(message, c, selectLabel(c.Placeholder, "Select a channel", nil), c.ValueLimits)
+		}
+	}
+}
+
+// sendComponentInteraction sends the interaction of the component of the
+// message, which is drawn as loading until the application responds.
+func (ml *messagesList) sendComponentInteraction(message discord.Message, customID discord.ComponentID, data any) {
+	key := componentKey{message.ID, customID}
+	setState := func(state componentState) {
+		app.QueueUpdateDraw(func() {
+			if state == 0 {
+				delete(ml.componentStates, key)
+			} else {
+				ml.componentStates[key] = state
+			}
+			ml.redrawMessage(key.messageID)
+		})
+	}
+
+	setState(componentLoading)
+
+	// The messages of user-installed applications are sent on their behalf.
+	appID := message.ApplicationID
+	if !appID.IsValid() {
+		appID = discord.AppID(message.Author.ID)
+	}
+
+	i := interaction{
+		Type:          discord.ComponentInteractionType,
+		ApplicationID: appID,
+		ChannelID:     message.ChannelID,
+		MessageID:     message.ID,
+		MessageFlags:  message.Flags,
+		Data:          data,
+	}
+	if channel, err := discordState.Cabinet.Channel(message.ChannelID); err == nil {
+		i.GuildID = channel.GuildID
+	}
+
+	err := app.interactions.send(i, func(err error) {
+		if err != nil {
+			setState(componentFailed)
+		} else {
+			setState(0)
+		}
+	})
+	if err != nil {
+		slog.Error("failed to press component", "err", err, "channel_id", message.ChannelID, "message_id", message.ID, "custom_id", customID)
+		setState(componentFailed)
+	}
+}
+
+// showStringSelect shows the options of the select menu to check. Only one
+// option can be checked unless the select menu allows more.
+func (ml *messagesList) showStringSelect(message discord.Message, c *discord.StringSelectComponent) {
+	minValues, maxValues := selectValueLimits(c.ValueLimits)
+
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(componentSelectPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	status := tview.NewTextView().SetDynamicColors(true)
+	checkboxes := make([]*tview.Checkbox, len(c.Options))
+	for i, option := range c.Options {
+		label := tview.Escape(option.Label)
+		if option.Emoji != nil {
+			label = componentEmojiText(*option.Emoji) + " " + label
+		}
+
+		checkboxes[i] = tview.NewCheckbox().
+			SetLabel(label).
+			SetChecked(option.Default)
+		checkboxes[i].SetChangedFunc(func(checked bool) {
+			if !checked || maxValues > 1 {
+				return
+			}
+
+			for j, c := range checkboxes {
+				if j != i {
+					c.SetChecked(false)
+				}
+			}
+		})
+		form.AddFormItem(checkboxes[i])
+	}
+
+	form.AddButton("Select", func() {
+		values := []string{}
+		for i, checkbox := range checkboxes {
+			if checkbox.IsChecked() {
+				values = append(values, c.Options[i].Value)
+			}
+		}
+
+		if len(values) < minValues || len(values) > maxValues {
+			status.SetText("[red]" + valueLimitsText(minValues, maxValues) + "[-]")
+			return
+		}
+
+		closeForm()
+		go ml.sendComponentInteraction(message, c.CustomID, selectInteractionData{
+			componentInteractionData: componentInteractionData{ComponentType: c.Type(), CustomID: c.CustomID},
+			Type:                     c.Type(),
+			Values:                   values,
+		})
+	})
+	form.AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(status, 1, 0, false)
+	flex.Box = ui.ConfigureBox(flex.Box, &ml.cfg.Theme)
+	flex.SetTitle(selectLabel(c.Placeholder, "Select an option", nil))
+
+	app.chatView.AddAndSwitchToPage(componentSelectPageName, ui.Centered(flex, 60, len(c.Options)*2+6), true).
+		ShowPage(flexPageName)
+}
+
+// showEntitySelect shows the field to enter the users, roles or channels of
+// the select menu, by name or ID and separated by commas.
+func (ml *messagesList) showEntitySelect(message discord.Message, component discord.InteractiveComponent, title string, limits [2]int) {
+	channel, err := discordState.Cabinet.Channel(message.ChannelID)
+	if err != nil {
+		slog.Error("failed to get channel from state", "err", err, "channel_id", message.ChannelID)
+		return
+	}
+
+	minValues, maxValues := selectValueLimits(limits)
+
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(componentSelectPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	status := tview.NewTextView().SetDynamicColors(true)
+	input := tview.NewInputField().
+		SetLabel("Values:").
+		SetPlaceholder("names or IDs, separated by commas")
+
+	form.
+		AddFormItem(input).
+		AddButton("Select", func() {
+			values, err := parseSelectValues(*channel, component.Type(), input.GetText())
+			if err != nil {
+				status.SetText("[red]" + tview.Escape(err.Error()) + "[-]")
+				return
+			}
+
+			if len(values) < minValues || len(values) > maxValues {
+				status.SetText("[red]" + valueLimitsText(minValues, maxValues) + "[-]")
+				return
+			}
+
+			closeForm()
+			go ml.sendComponentInteraction(message, component.ID(), selectInteractionData{
+				componentInteractionData: componentInteractionData{ComponentType: component.Type(), CustomID: component.ID()},
+				Type:                     component.Type(),
+				Values:                   values,
+			})
+		}).
+		AddButton("Cancel", closeForm).
+		SetCancelFunc(closeForm)
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(status, 1, 0, false)
+	flex.Box = ui.ConfigureBox(flex.Box, &ml.cfg.Theme)
+	flex.SetTitle(title)
+
+	app.chatView.AddAndSwitchToPage(componentSelectPageName, ui.Centered(flex, 60, 8), true).
+		ShowPage(flexPageName)
+}
+
+// parseSelectValues resolves the comma-separated users, roles or channels of
+// a select menu of the type to their IDs.
+func parseSelectValues(channel discord.Channel, typ discord.ComponentType, text string) ([]string, error) {
+	values := []string{}
+	for name := range strings.SplitSeq(text, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		var id discord.Snowflake
+		if typ == discord.UserSelectComponentType || typ == discord.MentionableSelectComponentType {
+			if userID, ok := lookupUser(channel, name); ok {
+				id = discord.Snowflake(userID)
+			}
+		}
+		if !id.IsValid() && (typ == discord.RoleSelectComponentType || typ == discord.MentionableSelectComponentType) {
+			if roleID, ok := lookupRole(channel, name); ok {
+				id = discord.Snowflake(roleID)
+			}
+		}
+		if typ == discord.ChannelSelectComponentType {
+			if c, ok := lookupChannel(channel, name); ok {
+				id = discord.Snowflake(c.ID)
+			}
+		}
+
+		if !id.IsValid() {
+			return nil, fmt.Errorf("%q not found", name)
+		}
+		values = append(values, id.String())
+	}
+
+	return values, nil
+}
+
+// modal is a form that an application responded to an interaction with.
+type modal struct {
+	id        discord.InteractionID
+	customID  discord.ComponentID
+	title     string
+	appID     discord.AppID
+	guildID   discord.GuildID
+	channelID discord.ChannelID
+	inputs    []modalInput
+}
+
+// modalInput is a text input of a modal. It is decoded here as arikawa drops
+// its length limits.
+type modalInput struct {
+	CustomID    discord.ComponentID    `json:"custom_id"`
+	Style       discord.TextInputStyle `json:"style"`
+	Label       string                 `json:"label"`
+	Description string                 `json:"-"`
+	MinLength   int                    `json:"min_length"`
+	MaxLength   int                    `json:"max_length"`
+	Required    bool                   `json:"required"`
+	Value       string                 `json:"value"`
+	Placeholder string                 `json:"placeholder"`
+
+	// labeled is whether the input is wrapped by a label component, which
+	// wraps its value when the modal is submitted too.
+	labeled bool
+}
+
+// parseModalInputs returns the text inputs of the component of a modal,
+// which is a text input, or an action row or a label that holds them.
+func parseModalInputs(raw json.RawMessage) []modalInput {
+	var c struct {
+		Type        discord.ComponentType `json:"type"`
+		Label       string                `json:"label"`
+		Description string                `json:"description"`
+		Component   json.RawMessage       `json:"component"`
+		Components  []json.RawMessage     `json:"components"`
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		slog.Error("failed to unmarshal modal component", "err", err)
+		return nil
+	}
+
+	switch c.Type {
+	case discord.ActionRowComponentType:
+		var inputs []modalInput
+		for _, raw := range c.Components {
+			inputs = append(inputs, parseModalInputs(raw)...)
+		}
+		return inputs
+	case labelComponentType:
+		inputs := parseModalInputs(c.Component)
+		for i := range inputs {
+			inputs[i].Label = c.Label
+			inputs[i].Description = c.Description
+			inputs[i].labeled = true
+		}
+		return inputs
+	case discord.TextInputComponentType:
+		// Text inputs are required unless they say otherwise.
+		input := modalInput{Required: true}
+		if err := json.Unmarshal(raw, &input); err != nil {
+			slog.Error("failed to unmarshal text input", "err", err)
+			return nil
+		}
+		return []modalInput{input}
+	default:
+		slog.Warn("unsupported modal component", "type", c.Type)
+		return nil
+	}
+}
+
+// validate returns why the value does not fit the input, if it does not.
+func (input modalInput) validate(value string) string {
+	n := utf8.RuneCountInString(value)
+	switch {
+	case n == 0 && input.Required:
+		return input.Label + " is required"
+	case n == 0:
+		return ""
+	case n < input.MinLength:
+		return fmt.Sprintf("%s must be at least %d characters", input.Label, input.MinLength)
+	case input.MaxLength > 0 && n > input.MaxLength:
+		return fmt.Sprintf("%s must be at most %d characters", input.Label, input.MaxLength)
+	}
+
+	return ""
+}
+
+// modalTextAreaHeight is the height of the text areas of the paragraph inputs
+// of modals.
+const modalTextAreaHeight = 4
+
+// showModal shows the text inputs of the modal to fill in and submit.
+func (ml *messagesList) showModal(m modal) {
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(modalPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	status := tview.NewTextView().SetDynamicColors(true)
+	fields := make([]interface{ GetText() string }, len(m.inputs))
+	height := 6
+	for i, input := range m.inputs {
+		label := tview.Escape(input.Label)
+		if input.Required {
+			label += "*"
+		}
+		label += ":"
+
+		placeholder := input.Placeholder
+		if placeholder == "" {
+			placeholder = input.Description
+		}
+
+		switch input.Style {
+		case discord.TextInputParagraphStyle:
+			field := tview.NewTextArea().
+				SetLabel(label).
+				SetPlaceholder(placeholder).
+				SetSize(modalTextAreaHeight, 0).
+				SetText(input.Value, true)
+			form.AddFormItem(field)
+			fields[i] = field
+			height += modalTextAreaHeight + 1
+		default:
+			field := tview.NewInputField().
+				SetLabel(label).
+				SetPlaceholder(placeholder).
+				SetText(input.Value)
+			form.AddFormItem(field)
+			fields[i] = field
+			height += 2
+		}
+	}
+
+	form.
+		AddButton("Submit", func() {
+			values := make([]string, len(fields))
+			for i, field := range fields {
+				values[i] = field.GetText()
+				if reason := m.inputs[i].validate(values[i]); reason != "" {
+					status.SetText("[red]" + tview.Escape(reason) + "[-]")
+					return
+				}
+			}
+
+			closeForm()
+			go ml.submitModal(m, values)
+		}).
+		AddButton("Cancel", closeForm).
+		SetCancelFunc(closeForm)
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(status, 1, 0, false)
+	flex.Box = ui.ConfigureBox(flex.Box, &ml.cfg.Theme)
+	flex.SetTitle(tview.Escape(m.title))
+
+	app.chatView.AddAndSwitchToPage(modalPageName, ui.Centered(flex, 70, height), true).
+		ShowPage(flexPageName)
+}
+
+type textInputValue struct {
+	Type     discord.ComponentType `json:"type"`
+	CustomID discord.ComponentID   `json:"custom_id"`
+	Value    string                `json:"value"`
+}
+
+// modalSubmitComponent holds a submitted text input the way that the modal
+// wrapped it: in an action row or a label.
+type modalSubmitComponent struct {
+	Type       discord.ComponentType `json:"type"`
+	Component  *textInputValue       `json:"component,omitempty"`
+	Components []textInputValue      `json:"components,omitempty"`
+}
+
+type modalSubmitData struct {
+	ID         discord.InteractionID  `json:"id"`
+	CustomID   discord.ComponentID    `json:"custom_id"`
+	Components []modalSubmitComponent `json:"components"`
+}
+
+// submitModal sends the values of the text inputs of the modal.
+func (ml *messagesList) submitModal(m modal, values []string) {
+	data := modalSubmitData{ID: m.id, CustomID: m.customID}
+	for i, input := range m.inputs {
+		value := textInputValue{Type: discord.TextInputComponentType, CustomID: input.CustomID, Value: values[i]}
+		if input.labeled {
+			data.Components = append(data.Components, modalSubmitComponent{Type: labelComponentType, Component: &value})
+		} else {
+			data.Components = append(data.Components, modalSubmitComponent{Type: discord.ActionRowComponentType, Components: []textInputValue{value}})
+		}
+	}
+
+	sb := app.chatView.statusBar
+	err := app.interactions.send(interaction{
+		Type:          discord.ModalInteractionType,
+		ApplicationID: m.appID,
+		GuildID:       m.guildID,
+		ChannelID:     m.channelID,
+		Data:          data,
+	}, func(err error) {
+		if err != nil {
+			sb.setTaskResult(fmt.Sprintf("[red]%s failed: %s[-]", tview.Escape(m.title), err))
+		}
+	})
+	if err != nil {
+		slog.Error("failed to submit modal", "err", err, "custom_id", m.customID, "channel_id", m.channelID)
+		sb.setTaskResult(fmt.Sprintf("[red]%s failed[-]", tview.Escape(m.title)))
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+// drafts holds the text that was typed in each channel but not sent, and
+// persists it across restarts. It is only used from the UI goroutine.
+type drafts struct {
+	path  string
+	texts map[discord.ChannelID]string
+}
+
+func newDrafts(path string) *drafts {
+	d := &drafts{
+		path:  path,
+		texts: make(map[discord.ChannelID]string),
+	}
+
+	d.load()
+	return d
+}
+
+func (d *drafts) load() {
+	data, err := os.ReadFile(d.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Warn("failed to load drafts", "err", err)
+		}
+		return
+	}
+
+	if err := json.Unmarshal(data, &d.texts); err != nil {
+		slog.Error("failed to parse drafts", "err", err)
+	}
+}
+
+func (d *drafts) save() {
+	data, err := json.Marshal(d.texts)
+	if err != nil {
+		slog.Error("failed to marshal drafts", "err", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(d.path), 0755); err != nil {
+		slog.Error("failed to create cache directory", "err", err)
+		return
+	}
+
+	tmp := d.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		slog.Error("failed to write drafts", "err", err)
+		return
+	}
+
+	if err := os.Rename(tmp, d.path); err != nil {
+		slog.Error("failed to write drafts", "err", err)
+	}
+}
+
+// get returns the draft of the channel, or an empty string if it has none.
+func (d *drafts) get(channelID discord.ChannelID) string {
+	return d.texts[channelID]
+}
+
+// set replaces the draft of the channel, removing it if the text is empty,
+// and saves the drafts if they changed.
+func (d *drafts) set(channelID discord.ChannelID, text string) {
+	if d.texts[channelID] == text {
+		return
+	}
+
+	if text == "" {
+		delete(d.texts, channelID)
+	} else {
+		d.texts[channelID] = text
+	}
+
+	d.save()
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	stdhttp "net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ayn2op/discordo/internal/export"
+	"github.com/ayn2op/discordo/internal/http"
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/httputil"
+	"github.com/spf13/cobra"
+)
+
+// exportDateLayout is the layout of the dates that bound the exported
+// messages, which are in local time.
+const exportDateLayout = "2006-01-02"
+
+var (
+	exportFormat      string
+	exportOutput      string
+	exportAfter       string
+	exportBefore      string
+	exportAuthors     []string
+	exportAttachments bool
+
+	exportCmd = &cobra.Command{
+		Use:   "export <channel-id>",
+		Short: "Export the history of a channel or DM",
+		Long: `Export the history of a channel or DM to a JSON, Markdown, HTML or plain text file.
+
+The messages are fetched from oldest to newest. An interrupted export resumes
+where it stopped when it is run again with the same output.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := loadLogger(); err != nil {
+				return err
+			}
+
+			channelID, err := discord.ParseSnowflake(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid channel ID: %w", err)
+			}
+
+			opts, err := newExportOptions(discord.ChannelID(channelID), exportFormat, exportOutput, exportAfter, exportBefore, exportAuthors, exportAttachments)
+			if err != nil {
+				return err
+			}
+
+			token := resolveToken()
+			if token == "" {
+				return errors.New("no token; log in first or pass --token")
+			}
+
+			client := http.NewClient(token)
+			client.OnRequest = append(client.OnRequest, httputil.WithHeaders(http.Headers()))
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+
+			opts.Progress = func(fetched int) {
+				fmt.Fprintf(os.Stderr, "\rFetched %d messages", fetched)
+			}
+
+			n, err := runExport(ctx, client, discord.ChannelID(channelID), opts)
+			fmt.Fprintln(os.Stderr)
+			if err != nil {
+				if errors.Is(err, context.Canceled) {
+					return errors.New("export interrupted; run the same command again to resume it")
+				}
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "Exported %d messages to %s\n", n, opts.Path)
+			return nil
+		},
+	}
+)
+
+func init() {
+	flags := exportCmd.Flags()
+	flags.StringVarP(&exportFormat, "format", "f", "", "json, markdown, html or text (default: from the extension of the output, or markdown)")
+	flags.StringVarP(&exportOutput, "output", "o", "", "path of the output file (default: <channel-id>.<ext>)")
+	flags.StringVar(&exportAfter, "after", "", "export the messages sent on or after the date (YYYY-MM-DD)")
+	flags.StringVar(&exportBefore, "before", "", "export the messages sent before the date (YYYY-MM-DD)")
+	flags.StringSliceVar(&exportAuthors, "author", nil, "export the messages of the author, by ID or name (repeatable)")
+	flags.BoolVar(&exportAttachments, "attachments", false, "download the attachments next to the output")
+
+	rootCmd.AddCommand(exportCmd)
+}
+
+// newExportOptions validates the options of an export. The format defaults to
+// the one of the extension of the output, and the output to a file named
+// after the channel in the current directory.
+func newExportOptions(channelID discord.ChannelID, format, output, after, before string, authors []string, attachments bool) (export.Options, error) {
+	opts := export.Options{Path: strings.TrimSpace(output), Attachments: attachments}
+
+	var err error
+	switch {
+	case strings.TrimSpace(format) != "":
+		opts.Format, err = export.ParseFormat(strings.TrimSpace(format))
+	case filepath.Ext(opts.Path) != "":
+		opts.Format, err = export.ParseFormat(filepath.Ext(opts.Path))
+	default:
+		opts.Format = export.FormatMarkdown
+	}
+	if err != nil {
+		return opts, err
+	}
+
+	if opts.Path == "" {
+		opts.Path = channelID.String() + opts.Format.Ext()
+	}
+
+	if opts.After, err = parseExportDate(after); err != nil {
+		return opts, fmt.Errorf("invalid after date: %w", err)
+	}
+	if opts.Before, err = parseExportDate(before); err != nil {
+		return opts, fmt.Errorf("invalid before date: %w", err)
+	}
+	if !opts.After.IsZero() && !opts.Before.IsZero() && !opts.After.Before(opts.Before) {
+		return opts, errors.New("the after date must be before the before date")
+	}
+
+	for _, a := range authors {
+		if a = strings.TrimPrefix(strings.TrimSpace(a), "@"); a != "" {
+			opts.Authors = append(opts.Authors, a)
+		}
+	}
+
+	return opts, nil
+}
+
+func parseExportDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation(exportDateLayout, value, time.Local)
+}
+
+func runExport(ctx context.Context, client *api.Client, channelID discord.ChannelID, opts export.Options) (int, error) {
+	files := &stdhttp.Client{Transport: http.NewTransport()}
+	return export.Export(ctx, client, files, channelID, opts)
+}
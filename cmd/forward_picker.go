@@ -0,0 +1,245 @@
+package cmd
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/discordo/internal/ui"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/httputil"
+	"github.com/gdamore/tcell/v3"
+	"github.com/sahilm/fuzzy"
+)
+
+// forwardDestination is a channel that a message can be forwarded to.
+type forwardDestination struct {
+	channel discord.Channel
+	// name is matched against the query.
+	name string
+}
+
+type forwardDestinations []forwardDestination
+
+func (fd forwardDestinations) String(i int) string {
+	return fd[i].name
+}
+
+func (fd forwardDestinations) Len() int {
+	return len(fd)
+}
+
+// forwardPicker lists the channels that a message can be forwarded to,
+// filtered by a fuzzy query, and takes an optional comment that is sent
+// after the forwarded message.
+type forwardPicker struct {
+	*tview.Flex
+	cfg *config.Config
+
+	input   *tview.InputField
+	list    *tview.List
+	comment *tview.InputField
+
+	destinations forwardDestinations
+	shown        forwardDestinations
+
+	onSelected func(channel discord.Channel, comment string)
+	onDone     func()
+}
+
+func newForwardPicker(cfg *config.Config) *forwardPicker {
+	fp := &forwardPicker{
+		Flex: tview.NewFlex(),
+		cfg:  cfg,
+
+		input:   tview.NewInputField(),
+		list:    tview.NewList(),
+		comment: tview.NewInputField(),
+	}
+
+	fp.input.
+		SetLabel("To: ").
+		SetChangedFunc(fp.filter)
+	fp.input.SetInputCapture(fp.onInputCapture)
+
+	fp.comment.
+		SetLabel("Comment: ").
+		SetPlaceholder("Optional")
+	fp.comment.SetInputCapture(fp.onInputCapture)
+
+	fp.list.
+		SetWrapAround(true).
+		SetHighlightFullLine(true).
+		ShowSecondaryText(false)
+	fp.list.SetSelectedFunc(func(index int, _, _ string, _ rune) {
+		fp.selectItem(index)
+	})
+
+	fp.Box = ui.ConfigureBox(fp.Box, &cfg.Theme)
+	fp.
+		SetDirection(tview.FlexRow).
+		AddItem(fp.input, 1, 0, true).
+		AddItem(fp.list, 0, 1, false).
+		AddItem(fp.comment, 1, 0, false).
+		SetTitle("Forward Message")
+
+	fp.destinations = forwardPickerDestinations()
+	fp.filter("")
+	return fp
+}
+
+// forwardPickerDestinations returns the channels that the current user can
+// send messages in: the DMs, most recent first, followed by the channels of
+// the guilds in the order of the guilds tree.
+func forwardPickerDestinations() forwardDestinations {
+	var destinations forwardDestinations
+
+	dms, _ := discordState.Cabinet.PrivateChannels()
+	slices.SortFunc(dms, func(a, b discord.Channel) int {
+		return cmp.Compare(dmLastMessageID(b), dmLastMessageID(a))
+	})
+	for _, c := range dms {
+		destinations = append(destinations, forwardDestination{channel: c, name: ui.ChannelToString(c)})
+	}
+
+	var guildIDs []discord.GuildID
+	app.chatView.guildsTree.GetRoot().Walk(func(node, _ *tview.TreeNode) bool {
+		if id, ok := node.GetReference().(discord.GuildID); ok {
+			guildIDs = append(guildIDs, id)
+		}
+		return true
+	})
+
+	for _, guildID := range guildIDs {
+		guild, err := discordState.Cabinet.Guild(guildID)
+		if err != nil {
+			continue
+		}
+
+		channels, err := discordState.Cabinet.Channels(guildID)
+		if err != nil {
+			continue
+		}
+
+		slices.SortFunc(channels, func(a, b discord.Channel) int {
+			return cmp.Compare(a.Position, b.Position)
+		})
+
+		for _, c := range channels {
+			switch c.Type {
+			case discord.GuildText, discord.GuildAnnouncement, discord.GuildPublicThread, discord.GuildPrivateThread, discord.GuildAnnouncementThread:
+			default:
+				continue
+			}
+
+			if c.ThreadMetadata != nil && c.ThreadMetadata.Archived {
+				continue
+			}
+
+			if !discordState.HasPermissions(c.ID, discord.PermissionViewChannel) || !canSendMessages(c) {
+				continue
+			}
+
+			destinations = append(destinations, forwardDestination{channel: c, name: guild.Name + " " + ui.ChannelToString(c)})
+		}
+	}
+
+	return destinations
+}
+
+// dmLastMessageID returns the ID of the last message of the DM channel, or of
+// the channel itself if it has no messages, which orders the DMs by activity.
+func dmLastMessageID(channel discord.Channel) discord.MessageID {
+	if channel.LastMessageID.IsValid() {
+		return channel.LastMessageID
+	}
+	return discord.MessageID(channel.ID)
+}
+
+// filter lists the destinations that match the query, best matches first.
+// All destinations are listed if the query is empty.
+func (fp *forwardPicker) filter(query string) {
+	fp.list.Clear()
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		fp.shown = fp.destinations
+	} else {
+		matches := fuzzy.FindFrom(query, fp.destinations)
+		fp.shown = make(forwardDestinations, len(matches))
+		for i, m := range matches {
+			fp.shown[i] = fp.destinations[m.Index]
+		}
+	}
+
+	for _, d := range fp.shown {
+		fp.list.AddItem(tview.Escape(d.name), "", 0, nil)
+	}
+}
+
+func (fp *forwardPicker) selectItem(index int) {
+	if index >= 0 && index < len(fp.shown) && fp.onSelected != nil {
+		fp.onSelected(fp.shown[index].channel, strings.TrimSpace(fp.comment.GetText()))
+	}
+}
+
+// onInputCapture moves the selection of the list while the query or the
+// comment is typed. Tab moves between the query and the comment.
+func (fp *forwardPicker) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	var key tcell.Key
+	switch event.Name() {
+	case "Up", fp.cfg.Keys.MentionsList.Up:
+		key = tcell.KeyUp
+	case "Down", fp.cfg.Keys.MentionsList.Down:
+		key = tcell.KeyDown
+	case "PgUp":
+		key = tcell.KeyPgUp
+	case "PgDn":
+		key = tcell.KeyPgDn
+	case "Tab", "Backtab":
+		if app.GetFocus() == fp.comment {
+			app.SetFocus(fp.input)
+		} else {
+			app.SetFocus(fp.comment)
+		}
+		return nil
+	case "Enter":
+		fp.selectItem(fp.list.GetCurrentItem())
+		return nil
+	case "Esc":
+		if fp.onDone != nil {
+			fp.onDone()
+		}
+		return nil
+	default:
+		return event
+	}
+
+	fp.list.InputHandler()(tcell.NewEventKey(key, "", tcell.ModNone), func(tview.Primitive) {})
+	return nil
+}
+
+// forwardMessage forwards the message to the channel. Forwarded messages are
+// sent like SendMessageComplex does, which cannot send them because it
+// requires content.
+func forwardMessage(channelID discord.ChannelID, message discord.Message) (*discord.Message, error) {
+	data := api.SendMessageData{
+		Reference: &discord.MessageReference{
+			Type:      discord.MessageReferenceTypeForward,
+			MessageID: message.ID,
+			ChannelID: message.ChannelID,
+			GuildID:   message.GuildID,
+		},
+	}
+
+	var forwarded *discord.Message
+	err := discordState.RequestJSON(
+		&forwarded, "POST",
+		api.EndpointChannels+channelID.String()+"/messages",
+		httputil.WithJSONBody(data),
+	)
+	return forwarded, err
+}
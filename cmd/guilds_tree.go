@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"github.com/ayn2op/discordo/internal/clipboard"
 	"github.com/ayn2op/discordo/internal/config"
@@ -23,6 +24,14 @@ import (
 	"github.com/gdamore/tcell/v3"
 )
 
+// archivedThreadsLimit is the number of archived threads listed under a text
+// channel when it is opened.
+const archivedThreadsLimit = 25
+
+// archivedThreadsTTL is how long the archived threads of a channel are listed
+// before they are fetched again when it is opened.
+const archivedThreadsTTL = 10 * time.Minute
+
 type guildsTree struct {
 	*tview.TreeView
 	cfg *config.Config
@@ -31,6 +40,10 @@ type guildsTree struct {
 	mutedGuilds   map[discord.GuildID]bool
 	mutedChannels map[discord.ChannelID]bool
 	cachePath     string // Path to persist mute cache
+
+	// archivedThreadsFetchedAt maps the channels to when their archived
+	// threads were last fetched. It is only used on the UI thread.
+	archivedThreadsFetchedAt map[discord.ChannelID]time.Time
 }
 
 func newGuildsTree(cfg *config.Config) *guildsTree {
@@ -42,6 +55,8 @@ func newGuildsTree(cfg *config.Config) *guildsTree {
 		mutedChannels: make(map[discord.ChannelID]bool),
 		cachePath:     cachePath,
 		cfg:           cfg,
+
+		archivedThreadsFetchedAt: make(map[discord.ChannelID]time.Time),
 	}
 
 	// Load mute cache from disk
@@ -60,6 +75,115 @@ func newGuildsTree(cfg *config.Config) *guildsTree {
 	return gt
 }
 
+// setGuildFolders replaces the nodes of the tree with the DMs node and the
+// guilds, grouped by folder.
+func (gt *guildsTree) setGuildFolders(folders []gateway.GuildFolder) {
+	root := gt.GetRoot()
+	dmNode := tview.NewTreeNode("Direct Messages")
+	root.ClearChildren().AddChild(dmNode)
+
+	for _, folder := range folders {
+		if folder.ID == 0 && len(folder.GuildIDs) == 1 {
+			guild, err := discordState.Cabinet.Guild(folder.GuildIDs[0])
+			if err != nil {
+				slog.Error(
+					"failed to get guild from state",
+					"guild_id",
+					folder.GuildIDs[0],
+					"err",
+					err,
+				)
+				continue
+			}
+
+			gt.createGuildNode(root, *guild)
+		} else {
+			gt.createFolderNode(folder)
+		}
+	}
+
+	gt.SetCurrentNode(root)
+}
+
+// resync rebuilds the tree from the state received on READY after a
+// reconnection, so that joined and left guilds, new DMs and folder changes are
+// shown. The tree is rebuilt rather than diffed, as its order comes from the
+// folders; the nodes that were loaded or expanded and the current node are
+// restored by their references, or their text if they have none.
+func (gt *guildsTree) resync(folders []gateway.GuildFolder) {
+	// Nodes without a reference (the DMs node and folders) are identified by
+	// their text.
+	key := func(node *tview.TreeNode) any {
+		if ref := node.GetReference(); ref != nil {
+			return ref
+		}
+		return node.GetText()
+	}
+
+	root := gt.GetRoot()
+	loaded := make(map[any]bool)
+	expanded := make(map[any]bool)
+	root.Walk(func(node, _ *tview.TreeNode) bool {
+		if node != root {
+			loaded[key(node)] = len(node.GetChildren()) != 0
+			expanded[key(node)] = node.IsExpanded()
+		}
+		return true
+	})
+
+	var current any
+	if node := gt.GetCurrentNode(); node != nil && node != root {
+		current = key(node)
+	}
+
+	gt.setGuildFolders(folders)
+	// The archived threads are gone with the channel nodes.
+	clear(gt.archivedThreadsFetchedAt)
+
+	// Children that are added while walking are walked as well.
+	var currentNode *tview.TreeNode
+	root.Walk(func(node, _ *tview.TreeNode) bool {
+		if node == root {
+			return true
+		}
+
+		k := key(node)
+		if loaded[k] {
+			switch ref := node.GetReference().(type) {
+			case discord.GuildID:
+				gt.createGuildChannelNodes(node, ref)
+			case nil:
+				// The DMs node is the first child of the root. Its channels
+				// are added right away, from the state of READY, so that
+				// they are restored too.
+				if node == root.GetChildren()[0] {
+					channels, err := discordState.Cabinet.PrivateChannels()
+					if err != nil {
+						slog.Error("failed to get private channels", "err", err)
+						break
+					}
+
+					gt.createDMNodes(node, channels)
+				}
+			}
+		}
+
+		if e, ok := expanded[k]; ok {
+			node.SetExpanded(e)
+		}
+
+		if k == current {
+			currentNode = node
+		}
+
+		return true
+	})
+
+	if currentNode != nil {
+		gt.SetCurrentNode(currentNode)
+	}
+}
+
 func (gt *guildsTree) createFolderNode(folder gateway.GuildFolder) {
 	name := "Folder"
 	if folder.Name != "" {
@@ -143,6 +267,10 @@ func (gt *guildsTree) createChannelNode(node *tview.TreeNode, channel discord.Ch
 		channelText = "[::d](muted)[::D] " + channelText
 	}
 
+	if channel.ThreadMetadata != nil && channel.ThreadMetadata.Archived {
+		channelText = "[::d](archived)[::D] " + channelText
+	}
+
 	channelNode := tview.NewTreeNode(channelText).
 		SetReference(channel.ID).
 		SetTextStyle(gt.getChannelNodeStyle(channel.ID))
@@ -169,29 +297,163 @@ PARENT_CHANNELS:
 	}
 
 	for _, channel := range channels {
-		if channel.ParentID.IsValid() {
-			var parent *tview.TreeNode
-			node.Walk(func(node, _ *tview.TreeNode) bool {
-				if node.GetReference() == channel.ParentID {
-					parent = node
-					return false
-				}
-
-				return true
-			})
+		if channel.ParentID.IsValid() && !isThread(channel.Type) {
+			if parent := findNode(node, channel.ParentID); parent != nil {
+				gt.createChannelNode(parent, channel)
+			}
+		}
+	}
 
-			if parent != nil {
+	// Threads are nested under their parent channels, which must exist first.
+	for _, channel := range channels {
+		if isThread(channel.Type) {
+			if parent := findNode(node, channel.ParentID); parent != nil {
 				gt.createChannelNode(parent, channel)
 			}
 		}
 	}
 }
 
+func (gt *guildsTree) createGuildChannelNodes(node *tview.TreeNode, guildID discord.GuildID) {
+	channels, err := discordState.Cabinet.Channels(guildID)
+	if err != nil {
+		slog.Error("failed to get channels", "err", err, "guild_id", guildID)
+		return
+	}
+
+	slices.SortFunc(channels, func(a, b discord.Channel) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	gt.createChannelNodes(node, channels)
+}
+
+// selectChannel makes the node of the channel the current node. The channels
+// of its guild are added to the tree first if they have not been loaded yet,
+// and the ancestors of the node are expanded.
+func (gt *guildsTree) selectChannel(channel discord.Channel) {
+	root := gt.GetRoot()
+	node := findNode(root, channel.ID)
+	if node == nil && channel.GuildID.IsValid() {
+		guildNode := findNode(root, channel.GuildID)
+		if guildNode == nil {
+			return
+		}
+
+		if len(guildNode.GetChildren()) == 0 {
+			gt.createGuildChannelNodes(guildNode, channel.GuildID)
+		}
+
+		if isThread(channel.Type) {
+			gt.addThreadNode(channel)
+		}
+
+		node = findNode(guildNode, channel.ID)
+	}
+
+	if node == nil {
+		return
+	}
+
+	for _, n := range gt.GetPath(node) {
+		n.SetExpanded(true)
+	}
+
+	gt.SetCurrentNode(node)
+}
+
+// findNode returns the first node under root that references the ID.
+func findNode(root *tview.TreeNode, reference any) *tview.TreeNode {
+	var found *tview.TreeNode
+	root.Walk(func(node, _ *tview.TreeNode) bool {
+		if node.GetReference() == reference {
+			found = node
+			return false
+		}
+
+		return true
+	})
+	return found
+}
+
+func isThread(t discord.ChannelType) bool {
+	return t == discord.GuildPublicThread || t == discord.GuildPrivateThread || t == discord.GuildAnnouncementThread
+}
+
+func isTextChannelNode(node *tview.TreeNode) bool {
+	channelID, ok := node.GetReference().(discord.ChannelID)
+	if !ok {
+		return false
+	}
+
+	channel, err := discordState.Cabinet.Channel(channelID)
+	if err != nil {
+		return false
+	}
+
+	return channel.Type == discord.GuildText || channel.Type == discord.GuildAnnouncement
+}
+
+// addThreadNode adds the thread under its parent channel node if the parent is
+// in the tree and the thread is not.
+func (gt *guildsTree) addThreadNode(thread discord.Channel) {
+	parent := findNode(gt.GetRoot(), thread.ParentID)
+	if parent == nil || findNode(parent, thread.ID) != nil {
+		return
+	}
+
+	gt.createChannelNode(parent, thread)
+}
+
+// loadArchivedThreads fetches the recently archived public threads of the
+// channel in the background and adds them under its node, unless they were
+// fetched less than archivedThreadsTTL ago. It must be called on the UI thread.
+func (gt *guildsTree) loadArchivedThreads(channel discord.Channel) {
+	if fetchedAt, ok := gt.archivedThreadsFetchedAt[channel.ID]; ok && time.Since(fetchedAt) < archivedThreadsTTL {
+		return
+	}
+	gt.archivedThreadsFetchedAt[channel.ID] = time.Now()
+
+	go gt.fetchArchivedThreads(channel)
+}
+
+func (gt *guildsTree) fetchArchivedThreads(channel discord.Channel) {
+	if !discordState.HasPermissions(channel.ID, discord.PermissionReadMessageHistory) {
+		return
+	}
+
+	threads, err := discordState.PublicArchivedThreads(channel.ID, discord.Timestamp{}, archivedThreadsLimit)
+	if err != nil {
+		slog.Error("failed to get archived threads", "err", err, "channel_id", channel.ID)
+		// Fetch them again the next time that the channel is opened.
+		app.QueueUpdate(func() {
+			delete(gt.archivedThreadsFetchedAt, channel.ID)
+		})
+		return
+	}
+
+	for i := range threads.Threads {
+		// Archived threads are not sent over the gateway; keep them in the
+		// state so that they can be opened like any other channel.
+		if err := discordState.Cabinet.ChannelSet(&threads.Threads[i], false); err != nil {
+			slog.Error("failed to set archived thread in state", "err", err, "thread_id", threads.Threads[i].ID)
+		}
+	}
+
+	app.QueueUpdateDraw(func() {
+		for _, thread := range threads.Threads {
+			gt.addThreadNode(thread)
+		}
+	})
+}
+
 func (gt *guildsTree) onSelected(node *tview.TreeNode) {
 	children := node.GetChildren()
 	slog.Debug("onSelected called", "text", node.GetText(), "children", len(children))
 
-	if len(children) != 0 {
+	// Text channels list their threads as children, but are opened rather than
+	// collapsed when selected.
+	if len(children) != 0 && !isTextChannelNode(node) {
 		node.SetExpanded(!node.IsExpanded())
 		return
 	}
@@ -222,17 +484,7 @@ func (gt *guildsTree) onSelected(node *tview.TreeNode) {
 			app.chatView.membersList.currentGuildID = ref
 		}
 
-		channels, err := discordState.Cabinet.Channels(ref)
-		if err != nil {
-			slog.Error("failed to get channels", "err", err, "guild_id", ref)
-			return
-		}
-
-		slices.SortFunc(channels, func(a, b discord.Channel) int {
-			return cmp.Compare(a.Position, b.Position)
-		})
-
-		gt.createChannelNodes(node, channels)
+		gt.createGuildChannelNodes(node, ref)
 		node.SetExpanded(true)
 	case discord.ChannelID:
 		channel, err := discordState.Cabinet.Channel(ref)
@@ -270,9 +522,7 @@ func (gt *guildsTree) onSelected(node *tview.TreeNode) {
 			// Filter for threads that belong to this forum channel
 			var forumThreads []discord.Channel
 			for _, ch := range allChannels {
-				if ch.ParentID == channel.ID && (ch.Type == discord.GuildPublicThread ||
-					ch.Type == discord.GuildPrivateThread ||
-					ch.Type == discord.GuildAnnouncementThread) {
+				if ch.ParentID == channel.ID && isThread(ch.Type) {
 					forumThreads = append(forumThreads, ch)
 				}
 			}
@@ -287,115 +537,79 @@ func (gt *guildsTree) onSelected(node *tview.TreeNode) {
 			return
 		}
 
-		// Do everything async to avoid blocking the UI thread
-		go func() {
-			slog.Info("fetching messages", "channel_id", channel.ID, "limit", gt.cfg.MessagesLimit)
-			messages, err := discordState.Messages(channel.ID, uint(gt.cfg.MessagesLimit))
-			if err != nil {
-				slog.Error("failed to get messages", "err", err, "channel_id", channel.ID, "limit", gt.cfg.MessagesLimit)
-				return
-			}
-			slog.Info("messages fetched", "channel_id", channel.ID, "count", len(messages))
-
-			// Mark channel as read with the actual latest message ID from fetched messages
-			if len(messages) > 0 {
-				latestMessageID := messages[0].ID
-				slog.Debug("marking channel as read", "channel_id", channel.ID, "latest_message_id", latestMessageID)
-				discordState.ReadState.MarkRead(channel.ID, latestMessageID)
-			}
-
-			if guildID := channel.GuildID; guildID.IsValid() {
-				app.chatView.messagesList.requestGuildMembers(guildID, messages)
-			}
-
-			hasNoPerm := channel.Type != discord.DirectMessage && channel.Type != discord.GroupDM && !discordState.HasPermissions(channel.ID, discord.PermissionSendMessages)
+		app.chatView.openChannel(channel)
 
-			// All UI updates must be on UI thread
-			app.QueueUpdateDraw(func() {
-				slog.Info("drawing messages", "channel_id", channel.ID, "count", len(messages))
-
-				app.chatView.selectedChannel = channel
-				app.chatView.messagesList.reset()
-				app.chatView.messagesList.setTitle(*channel)
-				app.chatView.messagesList.drawMessages(messages)
-				app.chatView.messagesList.ScrollToEnd()
-
-				app.chatView.messageInput.SetDisabled(hasNoPerm)
-				if hasNoPerm {
-					app.chatView.messageInput.SetPlaceholder("You do not have permission to send messages in this channel.")
-				} else {
-					app.chatView.messageInput.SetPlaceholder("Message...")
-					if gt.cfg.AutoFocus {
-						app.SetFocus(app.chatView.messageInput)
-					}
-				}
-			})
-		}()
+		if !app.chatView.offline && (channel.Type == discord.GuildText || channel.Type == discord.GuildAnnouncement) {
+			gt.loadArchivedThreads(*channel)
+		}
 
 		// Update channel style async (don't block onSelected callback)
 		go gt.updateChannelStyle(channel.ID, channel.GuildID)
 
 	case nil: // Direct messages folder
 		slog.Debug("selected Direct Messages folder - loading DM channels")
+		gt.loadDMs(node)
 
-		// Load DM channels asynchronously to avoid blocking the UI
-		go func() {
-			channels, err := discordState.PrivateChannels()
-			if err != nil {
-				slog.Error("failed to get private channels", "err", err)
-				return
-			}
-
-			slog.Info("loaded DM channels", "count", len(channels))
+		// Expand immediately to show loading state
+		node.SetExpanded(true)
+	}
+}
 
-			msgID := func(ch discord.Channel) discord.MessageID {
-				if ch.LastMessageID.IsValid() {
-					return ch.LastMessageID
-				}
-				return discord.MessageID(ch.ID)
-			}
+// loadDMs adds the DM channels to the node and expands it.
+func (gt *guildsTree) loadDMs(node *tview.TreeNode) {
+	// Load DM channels asynchronously to avoid blocking the UI
+	go func() {
+		channels, err := discordState.PrivateChannels()
+		if err != nil {
+			slog.Error("failed to get private channels", "err", err)
+			return
+		}
 
-			slices.SortFunc(channels, func(a, b discord.Channel) int {
-				// Descending order
-				return cmp.Compare(msgID(b), msgID(a))
-			})
+		slog.Info("loaded DM channels", "count", len(channels))
 
-			// Update UI on the main thread
-			app.QueueUpdateDraw(func() {
-				// Create all nodes with default style first (fast)
-				// Keep references to nodes for style updates
-				nodeRefs := make([]*tview.TreeNode, len(channels))
-				for i, c := range channels {
-					channelNode := tview.NewTreeNode(ui.ChannelToString(c)).
-						SetReference(c.ID)
-					node.AddChild(channelNode)
-					nodeRefs[i] = channelNode
-				}
-				node.SetExpanded(true)
-				slog.Info("DM nodes created", "count", len(channels))
-
-				// Update styles asynchronously in one batch (no expensive Walk operations)
-				go func() {
-					// Pre-compute all styles off the UI thread
-					styles := make([]tcell.Style, len(channels))
-					for i, c := range channels {
-						styles[i] = gt.getChannelNodeStyle(c.ID)
-					}
+		// Update UI on the main thread
+		app.QueueUpdateDraw(func() {
+			gt.createDMNodes(node, channels)
+			node.SetExpanded(true)
+		})
+	}()
+}
 
-					// Apply all styles in one UI update
-					app.QueueUpdateDraw(func() {
-						for i, style := range styles {
-							nodeRefs[i].SetTextStyle(style)
-						}
-						slog.Info("DM styles updated", "count", len(styles))
-					})
-				}()
-			})
-		}()
+// createDMNodes adds the DM channels to the node, ordered by their latest
+// message. Their styles are set afterwards.
+func (gt *guildsTree) createDMNodes(node *tview.TreeNode, channels []discord.Channel) {
+	slices.SortFunc(channels, func(a, b discord.Channel) int {
+		// Descending order
+		return cmp.Compare(dmLastMessageID(b), dmLastMessageID(a))
+	})
 
-		// Expand immediately to show loading state
-		node.SetExpanded(true)
+	// Create all nodes with default style first (fast)
+	// Keep references to nodes for style updates
+	nodeRefs := make([]*tview.TreeNode, len(channels))
+	for i, c := range channels {
+		channelNode := tview.NewTreeNode(ui.ChannelToString(c)).
+			SetReference(c.ID)
+		node.AddChild(channelNode)
+		nodeRefs[i] = channelNode
 	}
+	slog.Info("DM nodes created", "count", len(channels))
+
+	// Update styles asynchronously in one batch (no expensive Walk operations)
+	go func() {
+		// Pre-compute all styles off the UI thread
+		styles := make([]tcell.Style, len(channels))
+		for i, c := range channels {
+			styles[i] = gt.getChannelNodeStyle(c.ID)
+		}
+
+		// Apply all styles in one UI update
+		app.QueueUpdateDraw(func() {
+			for i, style := range styles {
+				nodeRefs[i].SetTextStyle(style)
+			}
+			slog.Info("DM styles updated", "count", len(styles))
+		})
+	}()
 }
 
 func (gt *guildsTree) collapseParentNode(node *tview.TreeNode) {
@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"log/slog"
+	neturl "net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/discordo/internal/preview"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/ningen/v3/discordmd"
+	"github.com/gdamore/tcell/v3"
+)
+
+// The size of a cell in pixels that the images are scaled for when the
+// terminal does not report it. kitty and iTerm2 scale the images to the cells
+// anyway.
+const (
+	defaultCellWidth  = 10
+	defaultCellHeight = 20
+)
+
+// imagePreviews loads the previews of the images of the messages and, for the
+// graphics protocols, draws them over the messages list.
+type imagePreviews struct {
+	cfg     *config.Config
+	loader  *preview.Loader
+	overlay *preview.Overlay
+	// cellWidth and cellHeight are the size of a cell in pixels.
+	cellWidth, cellHeight int
+
+	// waiting holds the messages whose segments wait for the images of the
+	// URLs to be loaded, and drawn those whose segments draw them.
+	waiting map[string]map[discord.MessageID]struct{}
+	drawn   map[string]map[discord.MessageID]struct{}
+}
+
+func newImagePreviews(cfg *config.Config, screen tcell.Screen) *imagePreviews {
+	protocol := preview.Protocol(cfg.ImagePreviews.Protocol)
+	switch protocol {
+	case preview.ProtocolKitty, preview.ProtocolITerm2, preview.ProtocolSixel, preview.ProtocolHalfBlock:
+	default:
+		if protocol != preview.ProtocolAuto {
+			slog.Warn("unknown image preview protocol; detecting it instead", "protocol", protocol)
+		}
+
+		name, _ := screen.Terminal()
+		protocol = preview.DetectProtocol(name)
+	}
+
+	var cellWidth, cellHeight int
+	if tty, ok := screen.Tty(); ok {
+		if size, err := tty.WindowSize(); err == nil {
+			cellWidth, cellHeight = size.CellDimensions()
+		}
+	}
+
+	if cellWidth == 0 || cellHeight == 0 {
+		cellWidth, cellHeight = defaultCellWidth, defaultCellHeight
+		// Sixel images are drawn pixel by pixel, so they would not fit the
+		// cells.
+		if protocol == preview.ProtocolSixel {
+			slog.Warn("the terminal does not report the size of its cells; falling back to half blocks")
+			protocol = preview.ProtocolHalfBlock
+		}
+	}
+
+	ip := &imagePreviews{
+		cfg:        cfg,
+		cellWidth:  cellWidth,
+		cellHeight: cellHeight,
+		waiting:    make(map[string]map[discord.MessageID]struct{}),
+		drawn:      make(map[string]map[discord.MessageID]struct{}),
+	}
+
+	maxSize := int(cfg.ImagePreviews.CacheSizeMB) << 20
+	ip.loader = preview.NewLoader(app.media, protocol, cellWidth, cellHeight, maxSize, func(url string) {
+		app.QueueUpdateDraw(func() {
+			ip.onChange(url)
+		})
+	})
+
+	if protocol.Graphics() {
+		ip.overlay = preview.NewOverlay(ip.loader)
+	}
+
+	slog.Info("image previews enabled", "protocol", protocol, "cell_width", cellWidth, "cell_height", cellHeight)
+	return ip
+}
+
+// onChange renders the messages that wait for the image of the URL, or that
+// draw it, again after it was loaded or evicted.
+func (ip *imagePreviews) onChange(url string) {
+	ml := app.chatView.messagesList
+	var changed bool
+	for _, ids := range []map[discord.MessageID]struct{}{ip.waiting[url], ip.drawn[url]} {
+		for id := range ids {
+			if ml.messageIndex(id) != -1 {
+				delete(ml.segments, id)
+				changed = true
+			}
+		}
+	}
+
+	delete(ip.waiting, url)
+	delete(ip.drawn, url)
+
+	if changed {
+		ml.redraw()
+	}
+}
+
+// lines returns the lines that draw the image of the URL in at most
+// maxCols x maxRows cells. If it is not loaded yet, the message is rendered
+// again once it is.
+func (ip *imagePreviews) lines(messageID discord.MessageID, url string, maxCols, maxRows int) ([]string, bool) {
+	img, ok := ip.loader.Get(url, maxCols, maxRows)
+	if !ok {
+		addMessageID(ip.waiting, url, messageID)
+		return nil, false
+	}
+
+	addMessageID(ip.drawn, url, messageID)
+	return img.Lines(ip.loader.Protocol()), true
+}
+
+// reset forgets the messages that wait for or draw images.
+func (ip *imagePreviews) reset() {
+	clear(ip.waiting)
+	clear(ip.drawn)
+}
+
+func addMessageID(m map[string]map[discord.MessageID]struct{}, url string, id discord.MessageID) {
+	if m[url] == nil {
+		m[url] = make(map[discord.MessageID]struct{})
+	}
+	m[url][id] = struct{}{}
+}
+
+// emoji returns the text that draws the custom emoji in two cells. Custom
+// emoji are too small to be drawn with half blocks.
+func (ip *imagePreviews) emoji(messageID discord.MessageID, emoji *discordmd.Emoji) (string, bool) {
+	if !ip.cfg.ImagePreviews.CustomEmoji || !ip.loader.Protocol().Graphics() {
+		return "", false
+	}
+
+	lines, ok := ip.lines(messageID, emoji.EmojiURL(), 2, 1)
+	if !ok {
+		return "", false
+	}
+
+	return strings.Join(lines, ""), true
+}
+
+// url returns the URL of the image downscaled by Discord's media proxy to the
+// size of the preview, so that the whole image is not downloaded. The proxy
+// URL is empty for images that are not proxied.
+func (ip *imagePreviews) url(proxyURL, url string, width, height uint) string {
+	if proxyURL == "" || width == 0 || height == 0 {
+		return url
+	}
+
+	u, err := neturl.Parse(proxyURL)
+	if err != nil {
+		return url
+	}
+
+	maxWidth := float64(int(ip.cfg.ImagePreviews.MaxWidth) * ip.cellWidth)
+	maxHeight := float64(int(ip.cfg.ImagePreviews.MaxHeight) * ip.cellHeight)
+	scale := min(maxWidth/float64(width), maxHeight/float64(height), 1)
+
+	q := u.Query()
+	q.Set("width", strconv.Itoa(max(int(float64(width)*scale), 1)))
+	q.Set("height", strconv.Itoa(max(int(float64(height)*scale), 1)))
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// isImage reports whether the attachment is an image that can be previewed.
+func isImage(a discord.Attachment) bool {
+	switch a.ContentType {
+	case "image/png", "image/jpeg", "image/gif", "image/webp":
+		return true
+	case "":
+		switch strings.ToLower(path.Ext(a.Filename)) {
+		case ".png", ".jpg", ".jpeg", ".gif", ".webp":
+			return true
+		}
+	}
+
+	return false
+}
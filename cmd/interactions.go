@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"sync"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/gateway"
+	"github.com/diamondburned/arikawa/v3/utils/sendpart"
+	"github.com/diamondburned/arikawa/v3/utils/ws"
+)
+
+// errNoResponse is the error of the interactions that the application did not
+// respond to in time.
+var errNoResponse = errors.New("the application did not respond")
+
+// interactionTimeout is how long the application has to respond to an
+// interaction once it is sent. Discord fails the interactions that are not
+// responded to in 3 seconds, but the event that tells so may be lost.
+const interactionTimeout = 15 * time.Second
+
+// interaction is an interaction sent by the current user: the invocation of a
+// command, the press of a component or the submission of a modal. It is sent
+// as a multipart form if it has files.
+type interaction struct {
+	Type          discord.InteractionDataType `json:"type"`
+	ApplicationID discord.AppID               `json:"application_id"`
+	GuildID       discord.GuildID             `json:"guild_id,omitempty"`
+	ChannelID     discord.ChannelID           `json:"channel_id"`
+	MessageID     discord.MessageID           `json:"message_id,omitempty"`
+	MessageFlags  discord.MessageFlags        `json:"message_flags,omitempty"`
+	SessionID     string                      `json:"session_id"`
+	Nonce         string                      `json:"nonce"`
+	Data          any                         `json:"data"`
+
+	files []sendpart.File
+}
+
+func (i interaction) NeedsMultipart() bool {
+	return len(i.files) > 0
+}
+
+func (i interaction) WriteMultipart(body *multipart.Writer) error {
+	w, err := body.CreateFormField("payload_json")
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(w).Encode(i); err != nil {
+		return err
+	}
+
+	for n, f := range i.files {
+		w, err := body.CreateFormFile(fmt.Sprintf("files[%d]", n), f.Name)
+		if err != nil {
+			return err
+		}
+
+		if _, err := io.Copy(w, f.Reader); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type pendingInteraction struct {
+	interaction interaction
+	onDone      func(error)
+	timer       *time.Timer
+}
+
+// interactionStore holds the interactions that are waiting for the
+// application to respond.
+type interactionStore struct {
+	mu sync.Mutex
+	// pending maps the nonces of the sent interactions to them.
+	pending map[string]pendingInteraction
+}
+
+func newInteractionStore() *interactionStore {
+	return &interactionStore{pending: make(map[string]pendingInteraction)}
+}
+
+// send sends the interaction. onDone is called from the gateway once the
+// application responds, or with errNoResponse if it does not or if it does not
+// in interactionTimeout; it is not called if the interaction fails to be sent.
+func (s *interactionStore) send(i interaction, onDone func(error)) error {
+	i.SessionID = discordState.Ready().SessionID
+	i.Nonce = discord.NewSnowflake(time.Now()).String()
+
+	s.mu.Lock()
+	s.pending[i.Nonce] = pendingInteraction{interaction: i, onDone: onDone}
+	s.mu.Unlock()
+
+	if err := sendpart.POST(discordState.Client.Client, i, nil, api.Endpoint+"interactions"); err != nil {
+		s.mu.Lock()
+		delete(s.pending, i.Nonce)
+		s.mu.Unlock()
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The application may have already responded.
+	if p, ok := s.pending[i.Nonce]; ok {
+		p.timer = time.AfterFunc(interactionTimeout, func() {
+			if p, ok := s.done(i.Nonce); ok {
+				slog.Error("application did not respond to interaction in time", "application_id", p.interaction.ApplicationID)
+				p.onDone(errNoResponse)
+			}
+		})
+		s.pending[i.Nonce] = p
+	}
+
+	return nil
+}
+
+// done removes the interaction with the nonce from the pending ones and
+// returns it.
+func (s *interactionStore) done(nonce string) (pendingInteraction, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.pending[nonce]
+	if ok {
+		delete(s.pending, nonce)
+		if p.timer != nil {
+			p.timer.Stop()
+		}
+	}
+
+	return p, ok
+}
+
+// clear fails the pending interactions. The responses to them are not sent to
+// a new gateway session.
+func (s *interactionStore) clear() {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[string]pendingInteraction)
+	s.mu.Unlock()
+
+	for _, p := range pending {
+		if p.timer != nil {
+			p.timer.Stop()
+		}
+
+		p.onDone(errNoResponse)
+	}
+}
+
+// interactionEvent is the data of the events that tell whether the
+// application responded to an interaction sent by the current user, which are
+// not known to arikawa.
+type interactionEvent struct {
+	ID    discord.InteractionID `json:"id"`
+	Nonce string                `json:"nonce"`
+}
+
+type (
+	interactionSuccessEvent struct{ interactionEvent }
+	interactionFailureEvent struct{ interactionEvent }
+)
+
+// interactionModalCreateEvent is sent when the application responds to an
+// interaction with a modal to fill in.
+type interactionModalCreateEvent struct {
+	interactionEvent
+	ChannelID   discord.ChannelID   `json:"channel_id"`
+	CustomID    discord.ComponentID `json:"custom_id"`
+	Title       string              `json:"title"`
+	Components  []json.RawMessage   `json:"components"`
+	Application struct {
+		ID discord.AppID `json:"id"`
+	} `json:"application"`
+}
+
+// The interaction events are dispatch events, whose op code is 0.
+func (*interactionSuccessEvent) Op() ws.OpCode               { return 0 }
+func (*interactionSuccessEvent) EventType() ws.EventType     { return "INTERACTION_SUCCESS" }
+func (*interactionFailureEvent) Op() ws.OpCode               { return 0 }
+func (*interactionFailureEvent) EventType() ws.EventType     { return "INTERACTION_FAILURE" }
+func (*interactionModalCreateEvent) Op() ws.OpCode           { return 0 }
+func (*interactionModalCreateEvent) EventType() ws.EventType { return "INTERACTION_MODAL_CREATE" }
+
+func init() {
+	gateway.OpUnmarshalers.Add(
+		func() ws.Event { return new(interactionSuccessEvent) },
+		func() ws.Event { return new(interactionFailureEvent) },
+		func() ws.Event { return new(interactionModalCreateEvent) },
+	)
+}
+
+func onInteractionSuccess(event *interactionSuccessEvent) {
+	if p, ok := app.interactions.done(event.Nonce); ok {
+		p.onDone(nil)
+	}
+}
+
+func onInteractionFailure(event *interactionFailureEvent) {
+	if p, ok := app.interactions.done(event.Nonce); ok {
+		slog.Error("application did not respond to interaction", "interaction_id", event.ID, "application_id", p.interaction.ApplicationID)
+		p.onDone(errNoResponse)
+	}
+}
+
+// onInteractionModalCreate shows the modal that the application responded
+// with, which is submitted as another interaction.
+func onInteractionModalCreate(event *interactionModalCreateEvent) {
+	p, ok := app.interactions.done(event.Nonce)
+	if ok {
+		p.onDone(nil)
+	}
+
+	m := modal{
+		id:        event.ID,
+		customID:  event.CustomID,
+		title:     event.Title,
+		appID:     event.Application.ID,
+		channelID: event.ChannelID,
+	}
+	if ok {
+		m.guildID = p.interaction.GuildID
+		if !m.appID.IsValid() {
+			m.appID = p.interaction.ApplicationID
+		}
+	}
+
+	for _, raw := range event.Components {
+		m.inputs = append(m.inputs, parseModalInputs(raw)...)
+	}
+
+	app.QueueUpdateDraw(func() {
+		app.chatView.messagesList.showModal(m)
+	})
+}
@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"bytes"
+	"cmp"
+	"log/slog"
+	"regexp"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+var customEmojiRegex = regexp.MustCompile(`:([a-zA-Z0-9_~]+):`)
+
+// mentionableRoles returns the roles of the guild of the channel that the
+// current user can mention in it: the mentionable ones, or all of them with
+// the permission to mention everyone.
+func mentionableRoles(channel discord.Channel) []discord.Role {
+	roles, err := discordState.Cabinet.Roles(channel.GuildID)
+	if err != nil {
+		slog.Error("failed to get roles from state", "err", err, "guild_id", channel.GuildID)
+		return nil
+	}
+
+	everyone := discordState.HasPermissions(channel.ID, discord.PermissionMentionEveryone)
+
+	var mentionable []discord.Role
+	for _, r := range roles {
+		// The @everyone role has the ID of the guild and is mentioned as is.
+		if discord.GuildID(r.ID) == channel.GuildID {
+			continue
+		}
+
+		if r.Mentionable || everyone {
+			mentionable = append(mentionable, r)
+		}
+	}
+
+	slices.SortFunc(mentionable, func(a, b discord.Role) int {
+		return cmp.Compare(b.Position, a.Position)
+	})
+	return mentionable
+}
+
+// mentionableChannels returns the channels and active threads of the guild
+// that the current user can see, ordered by their position.
+func mentionableChannels(guildID discord.GuildID) []discord.Channel {
+	channels, err := discordState.Cabinet.Channels(guildID)
+	if err != nil {
+		slog.Error("failed to get channels from state", "err", err, "guild_id", guildID)
+		return nil
+	}
+
+	var visible []discord.Channel
+	for _, c := range channels {
+		if c.Type == discord.GuildCategory {
+			continue
+		}
+
+		if c.ThreadMetadata != nil && c.ThreadMetadata.Archived {
+			continue
+		}
+
+		if discordState.HasPermissions(c.ID, discord.PermissionViewChannel) {
+			visible = append(visible, c)
+		}
+	}
+
+	slices.SortStableFunc(visible, func(a, b discord.Channel) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+	return visible
+}
+
+// customEmoji returns the custom emoji that can be used in the channel: those
+// of its guild that the roles of the current user allow, and with Nitro those
+// of every guild.
+func customEmoji(channel discord.Channel) []discord.Emoji {
+	guildIDs := []discord.GuildID{}
+	if channel.GuildID.IsValid() {
+		guildIDs = append(guildIDs, channel.GuildID)
+	}
+
+	if discordState.EmojiState.HasNitro() {
+		guilds, err := discordState.Cabinet.Guilds()
+		if err != nil {
+			slog.Error("failed to get guilds from state", "err", err)
+		}
+		for _, g := range guilds {
+			if g.ID != channel.GuildID {
+				guildIDs = append(guildIDs, g.ID)
+			}
+		}
+	}
+
+	var roleIDs []discord.RoleID
+	if me, err := discordState.Cabinet.Me(); err == nil && channel.GuildID.IsValid() {
+		if m, err := discordState.Cabinet.Member(channel.GuildID, me.ID); err == nil {
+			roleIDs = m.RoleIDs
+		}
+	}
+
+	var emoji []discord.Emoji
+	for _, guildID := range guildIDs {
+		emojis, err := discordState.Cabinet.Emojis(guildID)
+		if err != nil {
+			continue
+		}
+
+		for _, e := range emojis {
+			// The emoji restricted to roles of the guild can only be used by
+			// its members that have one of them.
+			if len(e.RoleIDs) > 0 && (guildID != channel.GuildID || !slices.ContainsFunc(e.RoleIDs, func(id discord.RoleID) bool {
+				return slices.Contains(roleIDs, id)
+			})) {
+				continue
+			}
+
+			emoji = append(emoji, e)
+		}
+	}
+
+	return emoji
+}
+
+// customEmojiMention returns the form of the custom emoji in messages.
+func customEmojiMention(e discord.Emoji) string {
+	if e.Animated {
+		return "<a:" + e.Name + ":" + e.ID.String() + ">"
+	}
+	return "<:" + e.Name + ":" + e.ID.String() + ">"
+}
+
+// mentionables holds the roles, channels and custom emoji that can be
+// mentioned in a channel, which are looked up once per sent message.
+type mentionables struct {
+	roles    []nameMention
+	channels []nameMention
+	emoji    []discord.Emoji
+}
+
+func newMentionables(c discord.Channel) mentionables {
+	var m mentionables
+	if c.GuildID.IsValid() {
+		for _, r := range mentionableRoles(c) {
+			m.roles = append(m.roles, nameMention{name: r.Name, mention: r.ID.Mention()})
+		}
+
+		for _, channel := range mentionableChannels(c.GuildID) {
+			m.channels = append(m.channels, nameMention{name: channel.Name, mention: channel.ID.Mention()})
+		}
+	}
+
+	m.emoji = customEmoji(c)
+	return m
+}
+
+// nameMention is a name that is typed after a prefix, such as @ or #, and the
+// mention that it is sent as.
+type nameMention struct {
+	name    string
+	mention string
+}
+
+// expandNames replaces the names that follow the prefix with their mentions.
+// Names may have spaces, so the longest ones are matched first, and a name
+// only matches if it is not followed by another character of a word. The
+// prefix has to start a word and not be part of a URL.
+func expandNames(src []byte, prefix byte, names []nameMention) []byte {
+	if len(names) == 0 {
+		return src
+	}
+
+	slices.SortFunc(names, func(a, b nameMention) int {
+		return cmp.Compare(len(b.name), len(a.name))
+	})
+
+	var out []byte
+	for i := 0; i < len(src); i++ {
+		// Mentions that are already expanded start with < and the prefix.
+		if src[i] != prefix || (i > 0 && src[i-1] == '<') || !startsWord(src, i) || inURL(src, i) {
+			out = append(out, src[i])
+			continue
+		}
+
+		rest := src[i+1:]
+		j := slices.IndexFunc(names, func(n nameMention) bool {
+			if len(rest) < len(n.name) || !bytes.EqualFold(rest[:len(n.name)], []byte(n.name)) {
+				return false
+			}
+
+			r, _ := utf8.DecodeRune(rest[len(n.name):])
+			return !isWordRune(r)
+		})
+		if j == -1 {
+			out = append(out, src[i])
+			continue
+		}
+
+		out = append(out, names[j].mention...)
+		i += len(names[j].name)
+	}
+
+	return out
+}
+
+// expandCustomEmoji replaces the :name: of the custom emoji with their form in
+// messages. Emoji that are already expanded are kept.
+func expandCustomEmoji(src []byte, emoji []discord.Emoji) []byte {
+	if len(emoji) == 0 {
+		return src
+	}
+
+	var out []byte
+	last := 0
+	for _, m := range customEmojiRegex.FindAllSubmatchIndex(src, -1) {
+		start, end := m[0], m[1]
+		if start > 0 && (src[start-1] == '<' || (src[start-1] == 'a' && start > 1 && src[start-2] == '<')) || inURL(src, start) {
+			continue
+		}
+
+		name := string(src[m[2]:m[3]])
+		i := slices.IndexFunc(emoji, func(e discord.Emoji) bool {
+			return e.Name == name
+		})
+		if i == -1 {
+			continue
+		}
+
+		out = append(out, src[last:start]...)
+		out = append(out, customEmojiMention(emoji[i])...)
+		last = end
+	}
+
+	return append(out, src[last:]...)
+}
+
+// startsWord reports whether the byte at i is at the start of the text or
+// after a character that is not part of a word.
+func startsWord(src []byte, i int) bool {
+	r, _ := utf8.DecodeLastRune(src[:i])
+	return i == 0 || !isWordRune(r)
+}
+
+// inURL reports whether the byte at i is part of a URL, that is, of a word
+// separated by spaces that has a scheme before it.
+func inURL(src []byte, i int) bool {
+	start := bytes.LastIndexFunc(src[:i], unicode.IsSpace) + 1
+	return bytes.Contains(src[start:i], []byte("://"))
+}
+
+// isWordRune reports whether the rune is part of a name that is completed in
+// the message input.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
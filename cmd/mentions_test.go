@@ -0,0 +1,62 @@
+package cmd
+
+import "testing"
+
+func TestExpandNames(t *testing.T) {
+	channels := []nameMention{
+		{name: "general", mention: "<#1>"},
+		{name: "general chat", mention: "<#2>"},
+		{name: "off-topic", mention: "<#3>"},
+	}
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"start of text", "#general", "<#1>"},
+		{"after space", "see #general please", "see <#1> please"},
+		{"after punctuation", "(#general)", "(<#1>)"},
+		{"case insensitive", "#General", "<#1>"},
+		{"longest name first", "#general chat now", "<#2> now"},
+		{"name with dash", "#off-topic!", "<#3>!"},
+		{"followed by word", "#generalized", "#generalized"},
+		{"unknown name", "#random", "#random"},
+		{"already expanded", "<#general>", "<#general>"},
+		{"inside word", "issue#general", "issue#general"},
+		{"fragment of URL", "https://example.com/docs#general", "https://example.com/docs#general"},
+		{"path of URL", "https://example.com/#general", "https://example.com/#general"},
+		{"after URL", "https://example.com #general", "https://example.com <#1>"},
+		{"several", "#general and #off-topic", "<#1> and <#3>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(expandNames([]byte(tt.src), '#', channels)); got != tt.want {
+				t.Errorf("expandNames(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandNamesRoles(t *testing.T) {
+	roles := []nameMention{{name: "mods", mention: "<@&1>"}}
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"role", "ping @mods", "ping <@&1>"},
+		{"email", "admin@mods.example", "admin@mods.example"},
+		{"URL path", "https://medium.com/@mods", "https://medium.com/@mods"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(expandNames([]byte(tt.src), '@', roles)); got != tt.want {
+				t.Errorf("expandNames(%q) = %q, want %q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -34,6 +35,9 @@ import (
 
 const tmpFilePattern = consts.Name + "_*.md"
 
+// maxInputHistory is the number of sent messages that can be recalled.
+const maxInputHistory = 100
+
 var mentionRegex = regexp.MustCompile("@[a-zA-Z0-9._]+")
 
 // emojiShortcodes maps emoji shortcodes to their Unicode characters
@@ -352,6 +356,25 @@ type messageInput struct {
 	mentionsList    *tview.List
 	emojiList       *tview.List
 	lastSearch      time.Time
+	// lastTyping is when the typing notification was last sent in the selected
+	// channel.
+	lastTyping time.Time
+	// draft is the text that was typed before a message was edited, which is
+	// restored afterwards.
+	draft string
+
+	// history holds the texts of the sent messages, oldest first, which are
+	// recalled like the history of a shell. historyIndex is the recalled
+	// one, or len(history) if none is.
+	history      []string
+	historyIndex int
+	// historyDraft is the text that was typed before the history was
+	// recalled, which is restored after the latest message.
+	historyDraft string
+
+	// title is the title of the input without the mode of the modal editing.
+	title string
+	vim   vimState
 }
 
 func newMessageInput(cfg *config.Config) *messageInput {
@@ -365,6 +388,7 @@ func newMessageInput(cfg *config.Config) *messageInput {
 	}
 	mi.Box = ui.ConfigureBox(mi.Box, &cfg.Theme)
 	mi.SetInputCapture(mi.onInputCapture)
+	mi.SetChangedFunc(mi.onChanged)
 	mi.
 		SetPlaceholder("Select a channel to start chatting").
 		SetPlaceholderStyle(tcell.StyleDefault.Dim(true)).
@@ -373,6 +397,7 @@ func newMessageInput(cfg *config.Config) *messageInput {
 			func() string { return string(clipboard.Read(clipboard.FmtText)) },
 		).
 		SetDisabled(true)
+	mi.setTitle("")
 
 	mi.mentionsList.Box = ui.ConfigureBox(mi.mentionsList.Box, &mi.cfg.Theme)
 	mi.mentionsList.
@@ -387,14 +412,169 @@ func newMessageInput(cfg *config.Config) *messageInput {
 	return mi
 }
 
+// typingInterval is how often the typing notification is sent while text is
+// being entered. Discord shows the user as typing for 10 seconds.
+const typingInterval = 8 * time.Second
+
+// onChanged notifies Discord that the user is typing in the selected channel,
+// at most once per typing interval.
+func (mi *messageInput) onChanged() {
+	channel := app.chatView.selectedChannel
+	if !mi.cfg.SendTyping || channel == nil || mi.edit || mi.GetText() == "" || time.Since(mi.lastTyping) < typingInterval {
+		return
+	}
+
+	mi.lastTyping = time.Now()
+	go func() {
+		if err := discordState.Typing(channel.ID); err != nil {
+			slog.Error("failed to send typing", "err", err, "channel_id", channel.ID)
+		}
+	}()
+}
+
+// reset clears the input, or restores the text that was typed before a
+// message was edited.
 func (mi *messageInput) reset() {
+	var text string
+	if mi.edit {
+		text = mi.draft
+	}
+
 	mi.edit = false
+	mi.draft = ""
 	mi.sendMessageData = &api.SendMessageData{}
-	mi.SetTitle("")
-	mi.SetText("", true)
+	mi.historyIndex = len(mi.history)
+	mi.historyDraft = ""
+	// A new message is typed in the insert mode.
+	mi.setVimMode(vimInsert)
+	mi.setTitle("")
+	mi.setText(text)
+}
+
+// setText replaces the text without notifying that the user is typing, as it
+// was not typed.
+func (mi *messageInput) setText(text string) {
+	mi.SetChangedFunc(nil)
+	mi.SetText(text, true)
+	mi.SetChangedFunc(mi.onChanged)
+}
+
+// unsentText returns the text that was typed but not sent, which is kept
+// aside while a message is edited.
+func (mi *messageInput) unsentText() string {
+	if mi.edit {
+		return mi.draft
+	}
+	return mi.GetText()
+}
+
+// switchChannel keeps the text that was typed in the previous channel as its
+// draft, and restores the draft of the channel.
+func (mi *messageInput) switchChannel(from, to *discord.Channel) {
+	if from != nil {
+		if from.ID == to.ID {
+			return
+		}
+		app.drafts.set(from.ID, mi.unsentText())
+	}
+
+	mi.reset()
+	mi.setText(app.drafts.get(to.ID))
+}
+
+// saveDraft keeps the text that was typed in the selected channel as its
+// draft.
+func (mi *messageInput) saveDraft() {
+	if channel := app.chatView.selectedChannel; channel != nil {
+		app.drafts.set(channel.ID, mi.unsentText())
+	}
+}
+
+// editMessage puts the content of the message in the input to edit it. The
+// text that was typed is restored once the message is edited.
+func (mi *messageInput) editMessage(message discord.Message) {
+	if !mi.edit {
+		mi.draft = mi.GetText()
+	}
+
+	mi.setTitle("Editing")
+	mi.edit = true
+	mi.setText(message.Content)
+	app.SetFocus(mi)
+}
+
+// addHistory adds the text to the history, unless it is the same as the latest
+// one, forgetting the oldest text if there are too many.
+func (mi *messageInput) addHistory(text string) {
+	if text == "" || (len(mi.history) > 0 && mi.history[len(mi.history)-1] == text) {
+		return
+	}
+
+	mi.history = append(mi.history, text)
+	if n := len(mi.history) - maxInputHistory; n > 0 {
+		mi.history = slices.Delete(mi.history, 0, n)
+	}
+	mi.historyIndex = len(mi.history)
+}
+
+// recallPrevious edits the last message of the current user in the channel if
+// nothing was typed, or else recalls the previous sent message if the cursor
+// is on the first line. It reports whether it did either.
+func (mi *messageInput) recallPrevious() bool {
+	if mi.edit || app.chatView.GetVisibile(mentionsListPageName) {
+		return false
+	}
+
+	recalling := mi.historyIndex < len(mi.history)
+	text, start, _ := mi.GetSelection()
+	if !recalling && mi.GetText() == "" && len(mi.sendMessageData.Files) == 0 && mi.sendMessageData.Reference == nil &&
+		app.chatView.messagesList.editLast() {
+		return true
+	}
+
+	if mi.historyIndex == 0 || text != "" || strings.Contains(mi.GetText()[:start], "\n") {
+		return false
+	}
+
+	if !recalling {
+		mi.historyDraft = mi.GetText()
+	}
+
+	mi.historyIndex--
+	mi.setText(mi.history[mi.historyIndex])
+	return true
+}
+
+// recallNext recalls the next sent message, or the text that was typed before
+// the history was recalled after the latest one, if the cursor is on the last
+// line. It reports whether it did.
+func (mi *messageInput) recallNext() bool {
+	if mi.edit || app.chatView.GetVisibile(mentionsListPageName) || mi.historyIndex >= len(mi.history) {
+		return false
+	}
+
+	text, _, end := mi.GetSelection()
+	if text != "" || strings.Contains(mi.GetText()[end:], "\n") {
+		return false
+	}
+
+	mi.historyIndex++
+	if mi.historyIndex < len(mi.history) {
+		mi.setText(mi.history[mi.historyIndex])
+	} else {
+		mi.setText(mi.historyDraft)
+		mi.historyDraft = ""
+	}
+	return true
 }
 
 func (mi *messageInput) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	if mi.cfg.Keys.MessageInput.Vim.Enabled {
+		if event = mi.onVimInputCapture(event); event == nil {
+			return nil
+		}
+	}
+
 	// Handle Ctrl+J for inserting newlines (multiline input)
 	if event.Key() == tcell.KeyCtrlJ {
 		currentText := mi.GetText()
@@ -432,10 +612,22 @@ func (mi *messageInput) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
 		if app.chatView.GetVisibile(mentionsListPageName) {
 			mi.stopTabCompletion()
 		} else {
+			// The discarded text can be recalled from the history.
+			if !mi.edit {
+				mi.addHistory(strings.TrimSpace(mi.GetText()))
+			}
 			mi.reset()
 		}
 
 		return nil
+	case mi.cfg.Keys.MessageInput.HistoryPrevious:
+		if mi.recallPrevious() {
+			return nil
+		}
+	case mi.cfg.Keys.MessageInput.HistoryNext:
+		if mi.recallNext() {
+			return nil
+		}
 	case mi.cfg.Keys.MessageInput.TabComplete:
 		go app.QueueUpdateDraw(func() { mi.tabComplete() })
 		return nil
@@ -481,6 +673,22 @@ func (mi *messageInput) send() {
 	if text == "" && len(mi.sendMessageData.Files) == 0 {
 		return
 	}
+	input := text
+
+	// /poll opens the poll composer with the rest of the text as the question.
+	if question, ok := strings.CutPrefix(text, "/poll"); ok && !mi.edit && (question == "" || unicode.IsSpace(rune(question[0]))) {
+		if len(mi.sendMessageData.Files) > 0 {
+			app.chatView.statusBar.setTaskResult("[red]polls cannot have attachments[-]")
+			return
+		}
+
+		mi.showCreatePoll(strings.TrimSpace(question))
+		return
+	}
+
+	if strings.HasPrefix(text, "/") && !mi.edit && mi.runCommand(text) {
+		return
+	}
 
 	// Close attached files on return
 	defer func() {
@@ -509,23 +717,204 @@ func (mi *messageInput) send() {
 	} else {
 		data := mi.sendMessageData
 		data.Content = text
-		if _, err := discordState.SendMessageComplex(app.chatView.selectedChannel.ID, *data); err != nil {
-			slog.Error("failed to send message in channel", "channel_id", app.chatView.selectedChannel.ID, "err", err)
-		} else {
-			// If we sent a message in a DM, move it to the top of the DM list
-			if app.chatView.selectedChannel != nil &&
-			   (app.chatView.selectedChannel.Type == discord.DirectMessage ||
-			    app.chatView.selectedChannel.Type == discord.GroupDM) {
-				go app.chatView.guildsTree.moveDMToTopOnMessage(app.chatView.selectedChannel.ID)
-			}
+
+		// The message is shown as pending until the outbox sends it.
+		message, err := app.outbox.add(*app.chatView.selectedChannel, *data)
+		if err != nil {
+			slog.Error("failed to queue message", "channel_id", app.chatView.selectedChannel.ID, "err", err)
+			return
 		}
+
+		app.chatView.messagesList.clearNewMessagesDivider()
+		app.chatView.messagesList.appendMessage(message)
+		mi.addHistory(input)
 	}
 
 	mi.reset()
+	// Sending a message ends typing.
+	mi.lastTyping = time.Time{}
 	app.chatView.messagesList.Highlight()
 	app.chatView.messagesList.ScrollToEnd()
 }
 
+// showCreatePoll shows the form to create a poll in the selected channel.
+func (mi *messageInput) showCreatePoll(question string) {
+	channel := *app.chatView.selectedChannel
+	var (
+		answers          string
+		duration         = "24"
+		allowMultiselect bool
+	)
+
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(createPollPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("Question:", question, 0, func(text string) {
+		question = text
+	})
+	form.AddTextArea("Answers (one per line):", "", 0, maxPollAnswers/2, 0, func(text string) {
+		answers = text
+	})
+	form.AddInputField("Duration (hours):", duration, 6, func(text string) {
+		duration = text
+	})
+	form.AddCheckbox("Multiple answers:", false, func(checked bool) {
+		allowMultiselect = checked
+	})
+	form.AddButton("Send", func() {
+		data, err := newCreatePollData(question, answers, duration, allowMultiselect)
+		if err != nil {
+			form.SetTitle("Create Poll - " + err.Error())
+			return
+		}
+
+		closeForm()
+		mi.reset()
+		go func() {
+			if _, err := sendPoll(channel.ID, data); err != nil {
+				slog.Error("failed to send poll", "err", err, "channel_id", channel.ID)
+			}
+		}()
+	})
+	form.AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+
+	form.Box = ui.ConfigureBox(form.Box, &mi.cfg.Theme)
+	form.SetTitle("Create Poll")
+
+	app.chatView.AddAndSwitchToPage(createPollPageName, ui.Centered(form, 70, 17), true).
+		ShowPage(flexPageName)
+}
+
+// runCommand invokes the application command that the text starts with. The
+// values of its options are asked for if the text does not have valid ones.
+// It reports whether the text starts with a command.
+func (mi *messageInput) runCommand(text string) bool {
+	channel := *app.chatView.selectedChannel
+	commands, _ := app.commands.commands(channel, nil)
+	fields := strings.Fields(text)
+	command := findAppCommand(commands, strings.TrimPrefix(fields[0], "/"))
+	if command == nil {
+		return false
+	}
+
+	// The attached files are kept for a message rather than dropped.
+	if len(mi.sendMessageData.Files) > 0 {
+		app.chatView.statusBar.setTaskResult(fmt.Sprintf("[red]/%s cannot have attachments[-]", tview.Escape(command.Name)))
+		return true
+	}
+
+	path, values, subcommands, n := resolveSubcommand(command.Options, fields[1:])
+	if subcommands != nil {
+		// The subcommand to invoke is picked from the mentions list.
+		mi.SetText(strings.Join(fields[:1+n], " ")+" ", true)
+		if mi.cfg.AutocompleteLimit > 0 {
+			mi.tabSuggestion()
+		}
+		return true
+	}
+
+	invocation := commandInvocation{
+		channel: channel,
+		command: *command,
+		path:    path,
+		values:  values,
+		args:    parseCommandArgs(commandArgs(text, 1+n), values),
+	}
+	if _, _, err := invocation.options(); err != nil {
+		mi.showCommandOptions(invocation)
+		return true
+	}
+
+	mi.reset()
+	go mi.invoke(invocation)
+	return true
+}
+
+func (mi *messageInput) invoke(invocation commandInvocation) {
+	err := invocation.invoke(func(err error) {
+		if err != nil {
+			app.chatView.statusBar.setTaskResult(fmt.Sprintf("[red]%s failed: %s[-]", invocation.String(), err))
+		}
+	})
+	if err != nil {
+		slog.Error("failed to invoke command", "err", err, "command", invocation.String(), "channel_id", invocation.channel.ID)
+		app.chatView.statusBar.setTaskResult(fmt.Sprintf("[red]%s failed[-]", invocation.String()))
+	}
+}
+
+// showCommandOptions shows the form to enter the values of the options of
+// the command, starting with the typed ones.
+func (mi *messageInput) showCommandOptions(invocation commandInvocation) {
+	invocation.args = maps.Clone(invocation.args)
+
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(commandOptionsPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	for _, v := range invocation.values {
+		name := v.Name()
+		label := name + ":"
+		if commandOptionRequired(v) {
+			label = name + "*:"
+		}
+
+		field := tview.NewInputField().
+			SetLabel(label).
+			SetText(invocation.args[name]).
+			SetPlaceholder(commandOptionDescription(v)).
+			SetChangedFunc(func(text string) {
+				invocation.args[name] = text
+			})
+
+		// The choices of the option, or true and false, are completed.
+		var choices []string
+		for _, c := range commandOptionChoices(v) {
+			choices = append(choices, c.name)
+		}
+		if v.Type() == discord.BooleanOptionType {
+			choices = []string{"true", "false"}
+		}
+		if len(choices) > 0 {
+			field.
+				SetAutocompleteStyles(tcell.ColorDefault, tcell.StyleDefault, tcell.StyleDefault.Reverse(true)).
+				SetAutocompleteFunc(func(text string) []string {
+					return slices.DeleteFunc(slices.Clone(choices), func(c string) bool {
+						return text != "" && fuzzyMatchScore(text, c) == 0
+					})
+				})
+		}
+
+		form.AddFormItem(field)
+	}
+
+	form.AddButton("Send", func() {
+		if _, _, err := invocation.options(); err != nil {
+			form.SetTitle(invocation.String() + " - " + err.Error())
+			return
+		}
+
+		closeForm()
+		mi.reset()
+		go mi.invoke(invocation)
+	})
+	form.AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+
+	form.Box = ui.ConfigureBox(form.Box, &mi.cfg.Theme)
+	form.SetTitle(invocation.String())
+
+	app.chatView.AddAndSwitchToPage(commandOptionsPageName, ui.Centered(form, 70, 2*len(invocation.values)+7), true).
+		ShowPage(flexPageName)
+}
+
 func processText(channel *discord.Channel, src []byte) string {
 	var (
 		ranges     [][2]int
@@ -534,7 +923,7 @@ func processText(channel *discord.Channel, src []byte) string {
 
 	ast.Walk(discordmd.Parse(src), func(node ast.Node, enter bool) (ast.WalkStatus, error) {
 		switch node := node.(type) {
-		case *ast.CodeBlock, *ast.FencedCodeBlock:
+		case *ast.CodeBlock, *ast.FencedCodeBlock, *ast.AutoLink, *ast.Link:
 			canMention = !enter
 		case *discordmd.Inline:
 			if (node.Attr & discordmd.AttrMonospace) != 0 {
@@ -542,21 +931,42 @@ func processText(channel *discord.Channel, src []byte) string {
 			}
 		case *ast.Text:
 			if canMention {
-				ranges = append(ranges, [2]int{node.Segment.Start,
-					node.Segment.Stop})
+				// Words with underscores are split into adjacent texts.
+				if n := len(ranges); n > 0 && ranges[n-1][1] == node.Segment.Start {
+					ranges[n-1][1] = node.Segment.Stop
+				} else {
+					ranges = append(ranges, [2]int{node.Segment.Start,
+						node.Segment.Stop})
+				}
 			}
 		}
 		return ast.WalkContinue, nil
 	})
 
-	for _, rng := range ranges {
-		src = slices.Replace(src, rng[0], rng[1], expandMentions(channel, src[rng[0]:rng[1]])...)
+	if len(ranges) == 0 {
+		return string(src)
+	}
+
+	m := newMentionables(*channel)
+	// The ranges are replaced from the last so that the expanded mentions do
+	// not move the ones before them.
+	for _, rng := range slices.Backward(ranges) {
+		src = slices.Replace(src, rng[0], rng[1], expandMentions(channel, m, src[rng[0]:rng[1]])...)
 	}
 
 	return string(src)
 }
 
-func expandMentions(c *discord.Channel, src []byte) []byte {
+// expandMentions replaces the @users, @roles, #channels and :emoji: that were
+// completed with their form in messages.
+func expandMentions(c *discord.Channel, m mentionables, src []byte) []byte {
+	src = expandUserMentions(c, src)
+	src = expandNames(src, '@', m.roles)
+	src = expandNames(src, '#', m.channels)
+	return expandCustomEmoji(src, m.emoji)
+}
+
+func expandUserMentions(c *discord.Channel, src []byte) []byte {
 	return mentionRegex.ReplaceAllFunc(src, func(input []byte) []byte {
 		output := input
 		name := string(input[1:])
@@ -589,9 +999,17 @@ func expandMentions(c *discord.Channel, src []byte) []byte {
 }
 
 func (mi *messageInput) tabComplete() {
-	posEnd, name, r := mi.GetWordUnderCursor(func(r rune) bool {
-		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
-	})
+	if start, suggestions, ok := mi.commandSuggestions(); ok {
+		mi.commandComplete(start, suggestions)
+		return
+	}
+
+	posEnd, name, r := mi.GetWordUnderCursor(isWordRune)
+	if r == '#' {
+		mi.channelComplete(posEnd-(len(name)+1), posEnd, name)
+		return
+	}
+
 	if r != '@' {
 		mi.stopTabCompletion()
 		return
@@ -633,10 +1051,34 @@ func (mi *messageInput) tabComplete() {
 	mi.stopTabCompletion()
 }
 
+// channelComplete replaces the #name under the cursor with the selected
+// channel, or the best match if the mentions list is disabled.
+func (mi *messageInput) channelComplete(pos, posEnd int, name string) {
+	var completion string
+	if mi.cfg.AutocompleteLimit == 0 {
+		gID := app.chatView.selectedChannel.GuildID
+		if !gID.IsValid() {
+			return
+		}
+
+		channels := channelSuggestions(gID, name)
+		if len(channels) == 0 {
+			return
+		}
+		completion = channels[0].Name
+	} else {
+		if mi.mentionsList.GetItemCount() == 0 {
+			return
+		}
+		_, completion = mi.mentionsList.GetItemText(mi.mentionsList.GetCurrentItem())
+	}
+
+	mi.Replace(pos, posEnd, "#"+completion+" ")
+	mi.stopTabCompletion()
+}
+
 func (mi *messageInput) emojiComplete() {
-	posEnd, name, r := mi.GetWordUnderCursor(func(r rune) bool {
-		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
-	})
+	posEnd, name, r := mi.GetWordUnderCursor(isWordRune)
 	if r != ':' {
 		mi.stopEmojiCompletion()
 		return
@@ -648,23 +1090,34 @@ func (mi *messageInput) emojiComplete() {
 	}
 	_, shortcode := mi.emojiList.GetItemText(mi.emojiList.GetCurrentItem())
 
-	// Get the emoji from the shortcode
-	if emoji, ok := emojiShortcodes[shortcode]; ok {
+	// Custom emoji are typed as :name: and expanded when the message is sent.
+	if strings.HasPrefix(shortcode, ":") {
+		mi.Replace(pos, posEnd, shortcode+" ")
+	} else if emoji, ok := emojiShortcodes[shortcode]; ok {
+		// Get the emoji from the shortcode
 		mi.Replace(pos, posEnd, emoji+" ")
 	}
 	mi.stopEmojiCompletion()
 }
 
 func (mi *messageInput) tabSuggestion() {
-	_, name, r := mi.GetWordUnderCursor(func(r rune) bool {
-		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.'
-	})
+	if _, suggestions, ok := mi.commandSuggestions(); ok {
+		mi.showCommandSuggestions(suggestions)
+		return
+	}
+
+	_, name, r := mi.GetWordUnderCursor(isWordRune)
 
 	if r == ':' {
 		mi.emojiSuggestion(name)
 		return
 	}
 
+	if r == '#' {
+		mi.channelSuggestion(name)
+		return
+	}
+
 	if r != '@' {
 		mi.stopTabCompletion()
 		return
@@ -747,6 +1200,8 @@ func (mi *messageInput) tabSuggestion() {
 				break
 			}
 		}
+
+		mi.addMentionRoles(name)
 	}
 
 	if mi.mentionsList.GetItemCount() == 0 {
@@ -757,6 +1212,46 @@ func (mi *messageInput) tabSuggestion() {
 	mi.showMentionList()
 }
 
+// channelSuggestion lists the channels of the guild that match the name.
+func (mi *messageInput) channelSuggestion(name string) {
+	mi.emojiList.Clear()
+	mi.mentionsList.Clear()
+
+	gID := app.chatView.selectedChannel.GuildID
+	if !gID.IsValid() {
+		mi.stopTabCompletion()
+		return
+	}
+
+	channels := channelSuggestions(gID, name)
+	for _, c := range channels[:min(len(channels), int(mi.cfg.AutocompleteLimit))] {
+		mi.mentionsList.AddItem(tview.Escape(ui.ChannelToString(c)), c.Name, 0, nil)
+	}
+
+	if mi.mentionsList.GetItemCount() == 0 {
+		mi.stopTabCompletion()
+		return
+	}
+
+	mi.showMentionList()
+}
+
+// channelSuggestions returns the channels of the guild that match the name,
+// best matches first, or all of them if it is empty.
+func channelSuggestions(gID discord.GuildID, name string) []discord.Channel {
+	channels := mentionableChannels(gID)
+	if name == "" {
+		return channels
+	}
+
+	res := fuzzy.FindFrom(name, channelList(channels))
+	matches := make([]discord.Channel, len(res))
+	for i, r := range res {
+		matches[i] = channels[r.Index]
+	}
+	return matches
+}
+
 func (mi *messageInput) emojiSuggestion(search string) {
 	mi.emojiList.Clear()
 
@@ -767,8 +1262,8 @@ func (mi *messageInput) emojiSuggestion(search string) {
 
 	// Collect matching emojis using fuzzy matching
 	type emojiMatch struct {
+		text      string
 		shortcode string
-		emoji     string
 		score     int
 	}
 
@@ -776,7 +1271,18 @@ func (mi *messageInput) emojiSuggestion(search string) {
 	for shortcode, emoji := range emojiShortcodes {
 		// Simple fuzzy match: check if all characters of search appear in order in shortcode
 		if matchScore := fuzzyMatchScore(search, shortcode); matchScore > 0 {
-			matches = append(matches, emojiMatch{shortcode, emoji, matchScore})
+			matches = append(matches, emojiMatch{fmt.Sprintf("%s  :%s:", emoji, shortcode), shortcode, matchScore})
+		}
+	}
+
+	// The custom emoji are completed with their colons.
+	for _, e := range customEmoji(*app.chatView.selectedChannel) {
+		if matchScore := fuzzyMatchScore(search, e.Name); matchScore > 0 {
+			text := ":" + e.Name + ":"
+			if e.Animated {
+				text += " [::d](animated)[::D]"
+			}
+			matches = append(matches, emojiMatch{text, ":" + e.Name + ":", matchScore})
 		}
 	}
 
@@ -796,7 +1302,7 @@ func (mi *messageInput) emojiSuggestion(search string) {
 
 	// Add matches to the emoji list
 	for _, match := range matches {
-		mi.emojiList.AddItem(fmt.Sprintf("%s  :%s:", match.emoji, match.shortcode), match.shortcode, 0, nil)
+		mi.emojiList.AddItem(match.text, match.shortcode, 0, nil)
 	}
 
 	if mi.emojiList.GetItemCount() == 0 {
@@ -807,6 +1313,169 @@ func (mi *messageInput) emojiSuggestion(search string) {
 	mi.showEmojiList()
 }
 
+// commandSuggestion is a completion of the word under the cursor while an
+// application command is typed.
+type commandSuggestion struct {
+	// text is shown in the mentions list.
+	text string
+	// completion replaces the word under the cursor.
+	completion string
+	score      int
+}
+
+// builtinCommands are the commands that are handled by the client.
+var builtinCommands = []struct{ name, description string }{
+	{"poll", "Create a poll"},
+}
+
+// commandSuggestions returns the completions of the word under the cursor,
+// and the offset that it starts at, if a command is typed before it: the
+// names of the commands, their subcommands, their options and the choices of
+// the options. It reports whether a command is typed.
+func (mi *messageInput) commandSuggestions() (int, []commandSuggestion, bool) {
+	channel := app.chatView.selectedChannel
+	if channel == nil || mi.edit {
+		return 0, nil, false
+	}
+
+	_, _, cursor := mi.GetSelection()
+	before := mi.GetText()[:cursor]
+	if !strings.HasPrefix(before, "/") || strings.ContainsRune(before, '\n') {
+		return 0, nil, false
+	}
+
+	// The suggestions are shown again once the commands are fetched.
+	commands, _ := app.commands.commands(*channel, func() {
+		app.QueueUpdateDraw(func() {
+			if app.GetFocus() == mi && mi.cfg.AutocompleteLimit > 0 {
+				mi.tabSuggestion()
+			}
+		})
+	})
+
+	start := strings.LastIndexFunc(before, unicode.IsSpace) + 1
+	word := before[start:]
+	words := strings.Fields(before[:start])
+
+	var suggestions []commandSuggestion
+	suggest := func(query, name, text, completion string) {
+		score := 1
+		if query != "" {
+			score = fuzzyMatchScore(query, name)
+		}
+		if score > 0 {
+			suggestions = append(suggestions, commandSuggestion{text: text, completion: completion, score: score})
+		}
+	}
+
+	if len(words) == 0 {
+		query := strings.TrimPrefix(word, "/")
+		for _, c := range builtinCommands {
+			suggest(query, c.name, fmt.Sprintf("/%s [::d]%s[::D]", c.name, c.description), "/"+c.name+" ")
+		}
+		for _, c := range commands {
+			description := c.Description
+			if c.appName != "" {
+				description += " - " + c.appName
+			}
+			suggest(query, c.Name, fmt.Sprintf("/%s [::d]%s[::D]", c.Name, tview.Escape(description)), "/"+c.Name+" ")
+		}
+	} else if command := findAppCommand(commands, strings.TrimPrefix(words[0], "/")); command != nil {
+		path, values, subcommands, n := resolveSubcommand(command.Options, words[1:])
+		switch {
+		case subcommands != nil:
+			// Nothing follows an unknown subcommand.
+			if n < len(words)-1 {
+				break
+			}
+
+			for _, o := range subcommands {
+				var description string
+				switch o := o.(type) {
+				case *discord.SubcommandGroupOption:
+					description = o.Description
+				case *discord.SubcommandOption:
+					description = o.Description
+				}
+				suggest(word, o.Name(), fmt.Sprintf("%s [::d]%s[::D]", o.Name(), tview.Escape(description)), o.Name()+" ")
+			}
+
+		case strings.Contains(word, ":"):
+			name, query, _ := strings.Cut(word, ":")
+			i := slices.IndexFunc(values, func(v discord.CommandOptionValue) bool {
+				return v.Name() == name
+			})
+			if i == -1 {
+				break
+			}
+
+			for _, c := range commandOptionChoices(values[i]) {
+				suggest(query, c.name, tview.Escape(c.name), fmt.Sprintf("%s:%v ", name, c.value))
+			}
+
+		default:
+			args := commandArgs(before, 1+len(path))
+			for _, v := range values {
+				if strings.Contains(" "+args, " "+v.Name()+":") {
+					continue
+				}
+
+				description := commandOptionDescription(v)
+				if commandOptionRequired(v) {
+					description += " (required)"
+				}
+				suggest(word, v.Name(), fmt.Sprintf("%s: [::d]%s[::D]", v.Name(), tview.Escape(description)), v.Name()+":")
+			}
+		}
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b commandSuggestion) int {
+		return b.score - a.score
+	})
+	if limit := int(mi.cfg.AutocompleteLimit); limit > 0 && len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return start, suggestions, true
+}
+
+func (mi *messageInput) showCommandSuggestions(suggestions []commandSuggestion) {
+	mi.emojiList.Clear()
+	mi.mentionsList.Clear()
+	for _, s := range suggestions {
+		mi.mentionsList.AddItem(s.text, s.completion, 0, nil)
+	}
+
+	if mi.mentionsList.GetItemCount() == 0 {
+		mi.stopTabCompletion()
+		return
+	}
+
+	mi.showMentionList()
+}
+
+// commandComplete replaces the word under the cursor with the selected
+// completion, or the best one if the mentions list is disabled, and lists the
+// completions of what follows.
+func (mi *messageInput) commandComplete(start int, suggestions []commandSuggestion) {
+	if len(suggestions) == 0 {
+		mi.stopTabCompletion()
+		return
+	}
+
+	completion := suggestions[0].completion
+	if mi.cfg.AutocompleteLimit > 0 && mi.mentionsList.GetItemCount() > 0 {
+		_, completion = mi.mentionsList.GetItemText(mi.mentionsList.GetCurrentItem())
+	}
+
+	_, _, cursor := mi.GetSelection()
+	mi.Replace(start, cursor, completion)
+
+	if mi.cfg.AutocompleteLimit > 0 {
+		mi.tabSuggestion()
+	}
+}
+
 // fuzzyMatchScore returns a score for how well the search matches the target
 // Returns 0 if no match, higher scores for better matches
 func fuzzyMatchScore(search, target string) int {
@@ -840,6 +1509,8 @@ func fuzzyMatchScore(search, target string) int {
 
 type memberList []discord.Member
 type userList []discord.User
+type roleList []discord.Role
+type channelList []discord.Channel
 
 func (ml memberList) String(i int) string {
 	return ml[i].Nick + ml[i].User.DisplayName + ml[i].User.Tag()
@@ -857,6 +1528,22 @@ func (ul userList) Len() int {
 	return len(ul)
 }
 
+func (rl roleList) String(i int) string {
+	return rl[i].Name
+}
+
+func (rl roleList) Len() int {
+	return len(rl)
+}
+
+func (cl channelList) String(i int) string {
+	return cl[i].Name
+}
+
+func (cl channelList) Len() int {
+	return len(cl)
+}
+
 // channelHasUser checks if a user has permission to view the specified channel
 func channelHasUser(channelID discord.ChannelID, userID discord.UserID) bool {
 	perms, err := discordState.Permissions(channelID, userID)
@@ -978,6 +1665,24 @@ func (mi *messageInput) addMentionUser(user *discord.User) {
 	mi.mentionsList.AddItem(name, user.Username, 0, nil)
 }
 
+// addMentionRoles adds the roles that match the name and can be mentioned in
+// the selected channel to the mentions list.
+func (mi *messageInput) addMentionRoles(name string) {
+	roles := mentionableRoles(*app.chatView.selectedChannel)
+	for _, r := range fuzzy.FindFrom(name, roleList(roles)) {
+		if mi.mentionsList.GetItemCount() >= int(mi.cfg.AutocompleteLimit) {
+			return
+		}
+
+		role := roles[r.Index]
+		text := "@" + tview.Escape(role.Name)
+		if role.Color != 0 {
+			text = fmt.Sprintf("[%s]%s[-]", role.Color, text)
+		}
+		mi.mentionsList.AddItem(text, role.Name, 0, nil)
+	}
+}
+
 // used by chatView
 func (mi *messageInput) removeMentionsList() {
 	app.chatView.
@@ -1068,6 +1773,8 @@ func (mi *messageInput) editor() {
 			return
 		}
 	})
+	// The editor drew over the images.
+	app.chatView.messagesList.resetImagePreviews()
 
 	msg, err := os.ReadFile(file.Name())
 	if err != nil {
@@ -1079,12 +1786,26 @@ func (mi *messageInput) editor() {
 }
 
 func (mi *messageInput) addTitle(s string) {
-	title := mi.GetTitle()
+	title := mi.title
 	if title != "" {
 		title += " | "
 	}
 
-	mi.SetTitle(title + s)
+	mi.setTitle(title + s)
+}
+
+// setTitle sets the title of the input, after the mode of the modal editing
+// if it is enabled.
+func (mi *messageInput) setTitle(title string) {
+	mi.title = title
+	if mi.cfg.Keys.MessageInput.Vim.Enabled {
+		if title != "" {
+			title = " | " + title
+		}
+		title = tview.Escape(mi.vim.indicator()) + title
+	}
+
+	mi.SetTitle(title)
 }
 
 func (mi *messageInput) openFilePicker() {
@@ -6,9 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
-	"net/http"
-	"os"
-	"path/filepath"
+	"regexp"
 	"slices"
 	"strings"
 	"sync"
@@ -16,7 +14,7 @@ import (
 
 	"github.com/ayn2op/discordo/internal/clipboard"
 	"github.com/ayn2op/discordo/internal/config"
-	"github.com/ayn2op/discordo/internal/consts"
+	"github.com/ayn2op/discordo/internal/export"
 	"github.com/ayn2op/discordo/internal/markdown"
 	"github.com/ayn2op/discordo/internal/ui"
 	"github.com/ayn2op/tview"
@@ -33,12 +31,53 @@ import (
 	"github.com/yuin/goldmark/text"
 )
 
+// defaultMessagesPageSize is the number of messages fetched per page when
+// messages_limit does not set one.
+const defaultMessagesPageSize = 50
+
 type messagesList struct {
 	*tview.TextView
 	cfg               *config.Config
 	selectedMessageID discord.MessageID
 
+	// messages is the loaded window of the selected channel, ordered from
+	// latest to oldest like the state's message store. It may hold older
+	// pages that were fetched on demand and are not kept in the state.
+	messages []discord.Message
+	// segments caches the rendered text of each loaded message so that a
+	// change to one message does not render the whole list again.
+	segments map[discord.MessageID]string
+	history  struct {
+		loading          bool
+		reachedBeginning bool
+		// The window does not reach the latest message when it was loaded
+		// around a message that was jumped to.
+		loadingNewer bool
+		reachedEnd   bool
+	}
+
+	// lastRead is the last message of the channel that was read when it was
+	// opened; the new messages divider is drawn before the messages after it.
+	lastRead struct {
+		channelID discord.ChannelID
+		messageID discord.MessageID
+	}
+
 	renderer *markdown.Renderer
+	// revealedSpoilers holds the messages whose spoilers are shown.
+	revealedSpoilers map[discord.MessageID]bool
+	// previews is nil if image previews are disabled.
+	previews *imagePreviews
+	// previewsWidth is the inner width that the image previews of the
+	// segments were scaled to.
+	previewsWidth int
+
+	// focusedComponent is the index of the focused component of the selected
+	// message, or -1 if its components are not focused.
+	focusedComponent int
+	// componentStates holds the components that were pressed and are waiting
+	// for the application, or that it failed to respond to.
+	componentStates map[componentKey]componentState
 
 	fetchingMembers struct {
 		mu    sync.Mutex
@@ -52,7 +91,12 @@ func newMessagesList(cfg *config.Config) *messagesList {
 	ml := &messagesList{
 		TextView: tview.NewTextView(),
 		cfg:      cfg,
+		segments: make(map[discord.MessageID]string),
 		renderer: markdown.NewRenderer(cfg.Theme.MessagesList),
+
+		revealedSpoilers: make(map[discord.MessageID]bool),
+		focusedComponent: -1,
+		componentStates:  make(map[componentKey]componentState),
 	}
 
 	ml.Box = ui.ConfigureBox(ml.Box, &cfg.Theme)
@@ -64,11 +108,24 @@ func newMessagesList(cfg *config.Config) *messagesList {
 		SetHighlightedFunc(ml.onHighlighted).
 		SetTitle("Messages").
 		SetInputCapture(ml.onInputCapture)
+	ml.SetMouseCapture(ml.onMouseCapture)
 	return ml
 }
 
 func (ml *messagesList) reset() {
 	ml.selectedMessageID = 0
+	ml.messages = nil
+	clear(ml.segments)
+	clear(ml.revealedSpoilers)
+	ml.focusedComponent = -1
+	clear(ml.componentStates)
+	if ml.previews != nil {
+		ml.previews.reset()
+	}
+	ml.history.loading = false
+	ml.history.reachedBeginning = false
+	ml.history.loadingNewer = false
+	ml.history.reachedEnd = false
 	ml.
 		Clear().
 		Highlight().
@@ -84,14 +141,484 @@ func (ml *messagesList) setTitle(channel discord.Channel) {
 	ml.SetTitle(title)
 }
 
+// setMessages replaces the loaded window with the provided messages, ordered
+// from latest to oldest, and draws them.
+func (ml *messagesList) setMessages(messages []discord.Message) {
+	ml.history.reachedBeginning = ml.cfg.MessagesLimit == 0 || len(messages) < int(ml.cfg.MessagesLimit)
+
+	// The messages that are not sent yet are the latest ones.
+	if channel := app.chatView.selectedChannel; channel != nil {
+		messages = append(app.outbox.messages(channel.ID), messages...)
+	}
+
+	ml.messages = messages
+	clear(ml.segments)
+	ml.history.loading = false
+	ml.history.loadingNewer = false
+	ml.history.reachedEnd = true
+	ml.redraw()
+}
+
+// setMessagesAround replaces the loaded window with messages fetched around
+// the target message, which is then selected. The latest message of the
+// channel decides whether newer messages have to be fetched when the end of
+// the window is reached.
+func (ml *messagesList) setMessagesAround(messages []discord.Message, targetID discord.MessageID, lastMessageID discord.MessageID) {
+	ml.messages = messages
+	clear(ml.segments)
+	ml.history.loading = false
+	ml.history.reachedBeginning = false
+	ml.history.loadingNewer = false
+	ml.history.reachedEnd = len(messages) == 0 || messages[0].ID >= lastMessageID
+	ml.redraw()
+	ml.selectMessage(targetID)
+}
+
+// selectMessage highlights the loaded message and scrolls to it.
+func (ml *messagesList) selectMessage(id discord.MessageID) {
+	ml.selectedMessageID = id
+	ml.Highlight(id.String())
+	ml.ScrollToHighlight()
+}
+
+// appendMessage adds a newly created message to the end of the list. It is
+// ignored while the window does not reach the latest message; it is fetched
+// along with the other newer messages instead.
+func (ml *messagesList) appendMessage(message discord.Message) {
+	if !ml.history.reachedEnd || ml.messageIndex(message.ID) != -1 {
+		return
+	}
+
+	ml.messages = slices.Insert(ml.messages, 0, message)
+	io.WriteString(ml, ml.segment(message))
+}
+
+// confirmMessage replaces the local message of an outbox entry with the
+// message that was created from it.
+func (ml *messagesList) confirmMessage(localID discord.MessageID, message discord.Message) {
+	i := ml.messageIndex(localID)
+	if i == -1 {
+		return
+	}
+
+	// The created message may have been appended already.
+	if ml.messageIndex(message.ID) != -1 {
+		ml.messages = slices.Delete(ml.messages, i, i+1)
+	} else {
+		ml.messages[i] = message
+	}
+
+	if ml.selectedMessageID == localID {
+		ml.selectedMessageID = message.ID
+	}
+
+	delete(ml.segments, localID)
+	ml.redraw()
+}
+
+// updateMessage replaces the loaded copy of the message and renders only its
+// segment again. Messages that are not loaded are ignored.
+func (ml *messagesList) updateMessage(message discord.Message) {
+	i := ml.messageIndex(message.ID)
+	if i == -1 {
+		return
+	}
+
+	ml.messages[i] = message
+	delete(ml.segments, message.ID)
+	ml.redraw()
+}
+
+// updateReactions applies the change to the reactions of the loaded copy of
+// the message and re-renders its segment. Messages that are not loaded are
+// ignored.
+func (ml *messagesList) updateReactions(id discord.MessageID, update func([]discord.Reaction) []discord.Reaction) {
+	i := ml.messageIndex(id)
+	if i == -1 {
+		return
+	}
+
+	ml.messages[i].Reactions = update(slices.Clone(ml.messages[i].Reactions))
+	delete(ml.segments, id)
+	ml.redraw()
+}
+
+// redrawAuthors renders the loaded messages of the users again after their
+// members in the guild, which give their names and colors, have changed.
+func (ml *messagesList) redrawAuthors(guildID discord.GuildID, userIDs map[discord.UserID]struct{}) {
+	if channel := app.chatView.selectedChannel; channel == nil || channel.GuildID != guildID {
+		return
+	}
+
+	var changed bool
+	for _, m := range ml.messages {
+		if _, ok := userIDs[m.Author.ID]; !ok {
+			continue
+		}
+
+		delete(ml.segments, m.ID)
+		changed = true
+	}
+
+	if changed {
+		ml.redraw()
+	}
+}
+
+// redrawGuild renders all the loaded messages again after the roles of the
+// guild, which give the colors of the authors, have changed.
+func (ml *messagesList) redrawGuild(guildID discord.GuildID) {
+	if channel := app.chatView.selectedChannel; channel == nil || channel.GuildID != guildID {
+		return
+	}
+
+	clear(ml.segments)
+	ml.redraw()
+}
+
+// redrawMessage renders the loaded message again, e.g. after state that is
+// shown alongside it, such as its thread, has changed.
+func (ml *messagesList) redrawMessage(id discord.MessageID) {
+	if ml.messageIndex(id) == -1 {
+		return
+	}
+
+	delete(ml.segments, id)
+	ml.redraw()
+}
+
+// deleteMessage drops the message and its segment from the list.
+func (ml *messagesList) deleteMessage(id discord.MessageID) {
+	i := ml.messageIndex(id)
+	if i == -1 {
+		return
+	}
+
+	ml.messages = slices.Delete(ml.messages, i, i+1)
+	delete(ml.segments, id)
+	ml.redraw()
+}
+
+// segment returns the rendered text of the message, rendering it only if it
+// has not been rendered since it was last changed. Messages with relative
+// timestamps are rendered every time, so that the timestamps are up to date.
+func (ml *messagesList) segment(message discord.Message) string {
+	if s, ok := ml.segments[message.ID]; ok {
+		return s
+	}
+
+	var b strings.Builder
+	ml.drawMessage(&b, message)
+	s := b.String()
+	if !markdown.HasRelativeTimestamp(message.Content) {
+		ml.segments[message.ID] = s
+	}
+
+	return s
+}
+
+// redraw writes the segments of the loaded window to the text view while
+// keeping the scroll position and the selected message highlighted if it is
+// still part of the list. The text view is rewritten as a whole, but only the
+// segments of messages that changed since the last redraw are rendered again.
+func (ml *messagesList) redraw() {
+	row, column := ml.GetScrollOffset()
+	_, _, _, height := ml.GetInnerRect()
+	atEnd := row+height >= ml.GetWrappedLineCount()
+
+	ml.Clear()
+	ml.drawMessages(ml.messages)
+
+	if ml.selectedMessageID.IsValid() {
+		ml.Highlight(ml.selectedMessageID.String())
+		if len(ml.GetHighlights()) == 0 {
+			ml.selectedMessageID = 0
+		}
+	}
+
+	if atEnd {
+		ml.ScrollToEnd()
+	} else {
+		ml.ScrollTo(row, column)
+	}
+}
+
 func (ml *messagesList) drawMessages(messages []discord.Message) {
 	writer := ml.BatchWriter()
 	defer writer.Close()
-	for _, m := range slices.Backward(messages) {
-		ml.drawMessage(writer, m)
+
+	ml.drawHistoryMarker(writer)
+
+	firstUnread := ml.firstUnreadIndex()
+	for i, m := range slices.Backward(messages) {
+		if i == firstUnread {
+			ml.drawNewMessagesDivider(writer)
+		}
+
+		io.WriteString(writer, ml.segment(m))
+	}
+
+	if ml.history.loadingNewer {
+		io.WriteString(writer, "[::d]── loading newer messages… ──[::D]\n")
 	}
 }
 
+func (ml *messagesList) drawHistoryMarker(w io.Writer) {
+	switch {
+	case ml.history.loading:
+		io.WriteString(w, "[::d]── loading older messages… ──[::D]\n")
+	case ml.history.reachedBeginning:
+		io.WriteString(w, "[::d]── beginning of channel ──[::D]\n")
+	}
+}
+
+func (ml *messagesList) drawNewMessagesDivider(w io.Writer) {
+	fg := ml.cfg.Theme.MessagesList.NewMessagesStyle.GetForeground()
+	bg := ml.cfg.Theme.MessagesList.NewMessagesStyle.GetBackground()
+	fmt.Fprintf(w, "[%s:%s]── new messages ──[-:-]\n", fg, bg)
+}
+
+// setLastRead sets the last read message of the channel, after which the new
+// messages divider is drawn. A zero message ID removes the divider.
+func (ml *messagesList) setLastRead(channelID discord.ChannelID, messageID discord.MessageID) {
+	ml.lastRead.channelID = channelID
+	ml.lastRead.messageID = messageID
+}
+
+// firstUnreadIndex returns the index of the oldest loaded message that was not
+// read when the channel was opened, or -1 if there is none or if older unread
+// messages may not be loaded.
+func (ml *messagesList) firstUnreadIndex() int {
+	channel := app.chatView.selectedChannel
+	lastReadID := ml.lastRead.messageID
+	if channel == nil || ml.lastRead.channelID != channel.ID || !lastReadID.IsValid() {
+		return -1
+	}
+
+	i := slices.IndexFunc(ml.messages, func(m discord.Message) bool {
+		return m.ID <= lastReadID
+	})
+
+	switch {
+	case i == 0:
+		// Every loaded message was read.
+		return -1
+	case i == -1:
+		// The last read message is older than the loaded window.
+		if !ml.history.reachedBeginning {
+			return -1
+		}
+		return len(ml.messages) - 1
+	default:
+		return i - 1
+	}
+}
+
+// clearNewMessagesDivider removes the new messages divider, e.g. once the user
+// sent a message in the channel.
+func (ml *messagesList) clearNewMessagesDivider() {
+	if ml.firstUnreadIndex() == -1 {
+		return
+	}
+
+	ml.lastRead.messageID = 0
+	ml.redraw()
+}
+
+// selectFirstUnread selects the oldest message that was not read when the
+// channel was opened and reports whether it is loaded.
+func (ml *messagesList) selectFirstUnread() bool {
+	i := ml.firstUnreadIndex()
+	if i == -1 {
+		return false
+	}
+
+	ml.selectMessage(ml.messages[i].ID)
+	return true
+}
+
+// jumpToFirstUnread selects the oldest message that was not read when the
+// channel was opened, loading the messages around the last read message if
+// it is older than the loaded window.
+func (ml *messagesList) jumpToFirstUnread() {
+	channel := app.chatView.selectedChannel
+	if channel == nil || ml.lastRead.channelID != channel.ID || !ml.lastRead.messageID.IsValid() {
+		return
+	}
+
+	if !ml.selectFirstUnread() && ml.messageIndex(ml.lastRead.messageID) == -1 {
+		go app.chatView.loadMessagesAround(channel.ID, ml.lastRead.messageID, nil)
+	}
+}
+
+// loadOlderMessages fetches the page of messages preceding the oldest loaded
+// message and prepends it to the list without moving the visible area.
+func (ml *messagesList) loadOlderMessages() {
+	channel := app.chatView.selectedChannel
+	if channel == nil || len(ml.messages) == 0 || ml.history.loading || ml.history.reachedBeginning {
+		return
+	}
+
+	ml.history.loading = true
+	ml.prependMessages(nil)
+
+	before := ml.messages[len(ml.messages)-1].ID
+	limit := uint(ml.cfg.MessagesLimit)
+	if limit == 0 {
+		limit = defaultMessagesPageSize
+	}
+
+	go func() {
+		slog.Info("fetching older messages", "channel_id", channel.ID, "before", before, "limit", limit)
+		messages, err := discordState.MessagesBefore(channel.ID, before, limit)
+		if err != nil {
+			slog.Error("failed to get older messages", "err", err, "channel_id", channel.ID, "before", before)
+		}
+
+		// Messages fetched from the API do not have the guild ID set.
+		for i := range messages {
+			messages[i].GuildID = channel.GuildID
+		}
+
+		if guildID := channel.GuildID; guildID.IsValid() && len(messages) > 0 {
+			ml.requestGuildMembers(guildID, messages)
+		}
+
+		app.QueueUpdateDraw(func() {
+			// The list was reset (e.g., another channel was selected) while fetching.
+			if len(ml.messages) == 0 || ml.messages[len(ml.messages)-1].ID != before {
+				return
+			}
+
+			ml.history.loading = false
+			if err == nil && len(messages) < int(limit) {
+				ml.history.reachedBeginning = true
+			}
+
+			ml.prependMessages(messages)
+		})
+	}()
+}
+
+// loadNewerMessages fetches the page of messages following the latest loaded
+// message and appends it to the list.
+func (ml *messagesList) loadNewerMessages() {
+	channel := app.chatView.selectedChannel
+	if channel == nil || len(ml.messages) == 0 || ml.history.loadingNewer || ml.history.reachedEnd {
+		return
+	}
+
+	ml.history.loadingNewer = true
+	ml.redraw()
+
+	after := ml.messages[0].ID
+	limit := uint(ml.cfg.MessagesLimit)
+	if limit == 0 {
+		limit = defaultMessagesPageSize
+	}
+
+	go func() {
+		slog.Info("fetching newer messages", "channel_id", channel.ID, "after", after, "limit", limit)
+		messages, err := discordState.MessagesAfter(channel.ID, after, limit)
+		if err != nil {
+			slog.Error("failed to get newer messages", "err", err, "channel_id", channel.ID, "after", after)
+		}
+
+		// Messages fetched from the API do not have the guild ID set.
+		for i := range messages {
+			messages[i].GuildID = channel.GuildID
+		}
+
+		if guildID := channel.GuildID; guildID.IsValid() && len(messages) > 0 {
+			ml.requestGuildMembers(guildID, messages)
+		}
+
+		app.QueueUpdateDraw(func() {
+			// The list was reset (e.g., another channel was selected) while fetching.
+			if len(ml.messages) == 0 || ml.messages[0].ID != after {
+				return
+			}
+
+			ml.history.loadingNewer = false
+			if err == nil && len(messages) < int(limit) {
+				ml.history.reachedEnd = true
+			}
+
+			ml.messages = append(messages, ml.messages...)
+			ml.redraw()
+		})
+	}()
+}
+
+// backfill fetches the messages that were created after the latest loaded
+// message, e.g. while the gateway was disconnected, and appends them. The
+// latest messages are loaded again if too many were missed.
+func (ml *messagesList) backfill() {
+	channel := app.chatView.selectedChannel
+	if channel == nil || !ml.history.reachedEnd {
+		return
+	}
+
+	i := slices.IndexFunc(ml.messages, func(m discord.Message) bool {
+		return !isLocalMessage(m)
+	})
+	if i == -1 {
+		return
+	}
+
+	after := ml.messages[i].ID
+	limit := uint(ml.cfg.MessagesLimit)
+	if limit == 0 {
+		limit = defaultMessagesPageSize
+	}
+
+	go func() {
+		slog.Info("backfilling messages", "channel_id", channel.ID, "after", after, "limit", limit)
+		messages, err := discordState.MessagesAfter(channel.ID, after, limit)
+		if err != nil {
+			slog.Error("failed to backfill messages", "err", err, "channel_id", channel.ID, "after", after)
+			return
+		}
+
+		// Messages fetched from the API do not have the guild ID set.
+		for i := range messages {
+			messages[i].GuildID = channel.GuildID
+		}
+
+		if guildID := channel.GuildID; guildID.IsValid() && len(messages) > 0 {
+			ml.requestGuildMembers(guildID, messages)
+		}
+
+		app.QueueUpdateDraw(func() {
+			// Another channel was selected while fetching.
+			if app.chatView.selectedChannel == nil || app.chatView.selectedChannel.ID != channel.ID || ml.messageIndex(after) == -1 {
+				return
+			}
+
+			if len(messages) >= int(limit) {
+				app.chatView.openChannel(app.chatView.selectedChannel)
+				return
+			}
+
+			for _, m := range slices.Backward(messages) {
+				ml.appendMessage(m)
+			}
+		})
+	}()
+}
+
+// prependMessages adds older messages to the start of the list and scrolls by
+// the number of added lines so that the visible area stays in place.
+func (ml *messagesList) prependMessages(messages []discord.Message) {
+	row, column := ml.GetScrollOffset()
+	lines := ml.GetWrappedLineCount()
+
+	ml.messages = append(ml.messages, messages...)
+	ml.redraw()
+
+	ml.ScrollTo(max(row, 0)+ml.GetWrappedLineCount()-lines, column)
+}
+
 func (ml *messagesList) drawMessage(writer io.Writer, message discord.Message) {
 	// Region tags are square brackets that contain a region ID in double quotes
 	// https://pkg.go.dev/github.com/ayn2op/tview#hdr-Regions_and_Highlights
@@ -121,17 +648,54 @@ func (ml *messagesList) drawMessage(writer io.Writer, message discord.Message) {
 		fmt.Fprint(writer, "joined the server.")
 	case discord.InlinedReplyMessage:
 		ml.drawReplyMessage(writer, message)
+	case discord.ChatInputCommandMessage, discord.ContextMenuCommand:
+		ml.drawCommandMessage(writer, message)
 	case discord.ChannelPinnedMessage:
 		ml.drawPinnedMessage(writer, message)
+	case discord.ThreadCreatedMessage:
+		ml.drawThreadCreatedMessage(writer, message)
+	case discord.ThreadStarterMessage:
+		ml.drawThreadStarterMessage(writer, message)
 	default:
 		ml.drawTimestamps(writer, message.Timestamp)
 		ml.drawAuthor(writer, message)
 	}
 
+	if isLocalMessage(message) {
+		ml.drawOutboxState(writer, message)
+	}
+
 	// Tags with no region ID ([""]) don't start new regions. They can therefore be used to mark the end of a region.
 	io.WriteString(writer, "[\"\"]\n")
 }
 
+// isLocalMessage reports whether the message is the local message of an
+// outbox entry, whose nonce is its own ID.
+func isLocalMessage(message discord.Message) bool {
+	return message.Nonce != "" && message.Nonce == message.ID.String()
+}
+
+// drawOutboxState marks the local message as being sent or as failed.
+func (ml *messagesList) drawOutboxState(w io.Writer, message discord.Message) {
+	e, ok := app.outbox.entry(message.ID)
+	if !ok {
+		return
+	}
+
+	if !e.Failed {
+		io.WriteString(w, " [::d](sending…)[::D]")
+		return
+	}
+
+	fmt.Fprintf(
+		w,
+		"\n[red]Failed to send: %s[-] [::d](%s to retry, %s to discard)[::D]",
+		tview.Escape(e.Err),
+		ml.cfg.Keys.MessagesList.RetrySend,
+		ml.cfg.Keys.MessagesList.Delete,
+	)
+}
+
 func (ml *messagesList) formatTimestamp(ts discord.Timestamp) string {
 	return ts.Time().In(time.Local).Format(ml.cfg.Timestamps.Format)
 }
@@ -169,12 +733,19 @@ func (ml *messagesList) drawAuthor(w io.Writer, message discord.Message) {
 }
 
 func (ml *messagesList) drawContent(w io.Writer, message discord.Message) {
-	c := []byte(tview.Escape(message.Content))
 	if app.cfg.Markdown {
+		// The renderer escapes the text itself.
+		c := []byte(message.Content)
 		ast := discordmd.ParseWithMessage(c, *discordState.Cabinet, &message, false)
+		ml.renderer.SetRevealSpoilers(ml.revealedSpoilers[message.ID])
+		if ml.previews != nil {
+			ml.renderer.SetEmojiFunc(func(emoji *discordmd.Emoji) (string, bool) {
+				return ml.previews.emoji(message.ID, emoji)
+			})
+		}
 		ml.renderer.Render(w, c, ast)
 	} else {
-		w.Write(c) // write the content as is
+		io.WriteString(w, tview.Escape(message.Content)) // write the content as is
 	}
 }
 
@@ -202,7 +773,7 @@ func (ml *messagesList) drawDefaultMessage(w io.Writer, message discord.Message)
 
 	// Render reactions
 	if len(message.Reactions) > 0 {
-		ml.drawReactions(w, message.Reactions)
+		ml.drawReactions(w, message.ID, message.Reactions)
 	}
 
 	for _, a := range message.Attachments {
@@ -215,15 +786,236 @@ func (ml *messagesList) drawDefaultMessage(w io.Writer, message discord.Message)
 		} else {
 			fmt.Fprintf(w, "[%s:%s]%s[-:-]", fg, bg, a.Filename)
 		}
+
+		if ml.previews != nil && isImage(a) {
+			ml.drawImagePreview(w, message.ID, ml.previews.url(a.Proxy, a.URL, a.Width, a.Height), "")
+		}
+	}
+
+	for _, sticker := range message.Stickers {
+		fg := ml.cfg.Theme.MessagesList.AttachmentStyle.GetForeground()
+		bg := ml.cfg.Theme.MessagesList.AttachmentStyle.GetBackground()
+		fmt.Fprintf(w, "\n[%s:%s]Sticker: %s[-:-]", fg, bg, tview.Escape(sticker.Name))
+	}
+
+	if p, ok := app.polls.poll(message.ID); ok {
+		ml.drawPoll(w, p)
 	}
 
 	// Render embeds (from bots, etc.)
 	for _, embed := range message.Embeds {
-		ml.drawEmbed(w, embed)
+		ml.drawEmbed(w, message.ID, embed)
 	}
+
+	ml.drawComponents(w, message)
+	ml.drawThreadIndicator(w, message)
 }
 
-func (ml *messagesList) drawReactions(w io.Writer, reactions []discord.Reaction) {
+// pollBarWidth is the width of the bars that show the share of the votes of
+// each answer of a poll.
+const pollBarWidth = 20
+
+// drawPoll draws the question of the poll, its answers with their votes and
+// when it ends.
+func (ml *messagesList) drawPoll(w io.Writer, p poll) {
+	fmt.Fprintf(w, "\n[::b]%s[::B]", pollMediaText(p.Question))
+
+	fg := ml.cfg.Theme.MessagesList.PollStyle.GetForeground()
+	bg := ml.cfg.Theme.MessagesList.PollStyle.GetBackground()
+	total := p.totalVotes()
+	for _, answer := range p.Answers {
+		count, me := p.count(answer.AnswerID)
+
+		var filled, percent int
+		if total > 0 {
+			filled = pollBarWidth * count / total
+			percent = 100 * count / total
+		}
+
+		fmt.Fprintf(w, "\n[%s:%s]%s[-:-][::d]%s[::D] %3d%% %s [::d](%d)[::D]",
+			fg, bg, strings.Repeat("█", filled),
+			strings.Repeat("░", pollBarWidth-filled),
+			percent, pollMediaText(answer.PollMedia), count,
+		)
+		if me {
+			io.WriteString(w, " ✓")
+		}
+	}
+
+	votes := "votes"
+	if total == 1 {
+		votes = "vote"
+	}
+
+	status := "ends " + p.Expiry.Time().In(time.Local).Format("Jan 2 15:04")
+	switch {
+	case p.closed():
+		status = "closed"
+	case !p.Expiry.IsValid():
+		status = "open"
+	}
+
+	fmt.Fprintf(w, "\n[::d]%d %s · %s", total, votes, status)
+	if p.AllowMultiselect {
+		io.WriteString(w, " · multiple answers")
+	}
+	io.WriteString(w, "[::D]")
+}
+
+func pollMediaText(media pollMedia) string {
+	text := tview.Escape(media.Text)
+	if media.Emoji != nil {
+		text = componentEmojiText(*media.Emoji) + " " + text
+	}
+
+	return text
+}
+
+func componentEmojiText(emoji discord.ComponentEmoji) string {
+	if emoji.ID.IsValid() {
+		return ":" + emoji.Name + ":"
+	}
+
+	return emoji.Name
+}
+
+// drawComponents draws a line for each row of components of the message,
+// listing its buttons and select menus.
+func (ml *messagesList) drawComponents(w io.Writer, message discord.Message) {
+	focused := -1
+	if message.ID == ml.selectedMessageID {
+		focused = ml.focusedComponent
+	}
+
+	var n int
+	for _, component := range message.Components {
+		io.WriteString(w, "\n")
+
+		row, ok := component.(*discord.ActionRowComponent)
+		if !ok {
+			io.WriteString(w, "[::d](unsupported component)[::D]")
+			continue
+		}
+
+		for i, c := range *row {
+			if i > 0 {
+				io.WriteString(w, " ")
+			}
+
+			ml.drawComponent(w, c, n == focused)
+			switch ml.componentStates[componentKey{message.ID, c.ID()}] {
+			case componentLoading:
+				io.WriteString(w, " [::d]…[::D]")
+			case componentFailed:
+				io.WriteString(w, " [red]✗[-]")
+			}
+			n++
+		}
+	}
+}
+
+func (ml *messagesList) drawComponent(w io.Writer, component discord.InteractiveComponent, focused bool) {
+	var (
+		label    string
+		color    = "default"
+		disabled bool
+	)
+	switch c := component.(type) {
+	case *discord.ButtonComponent:
+		label = tview.Escape(c.Label)
+		if c.Emoji != nil {
+			label = strings.TrimSpace(componentEmojiText(*c.Emoji) + " " + label)
+		}
+
+		switch c.Style {
+		case discord.PrimaryButtonStyle():
+			color = "blue"
+		case discord.SecondaryButtonStyle():
+			color = "gray"
+		case discord.SuccessButtonStyle():
+			color = "green"
+		case discord.DangerButtonStyle():
+			color = "red"
+		default:
+			// Link buttons open their URL instead of sending an interaction.
+			label += " ↗"
+		}
+		disabled = c.Disabled
+	case *discord.StringSelectComponent:
+		var selected []string
+		for _, option := range c.Options {
+			if option.Default {
+				selected = append(selected, option.Label)
+			}
+		}
+
+		label = selectLabel(c.Placeholder, "Select an option", selected)
+		disabled = c.Disabled
+	case *discord.UserSelectComponent:
+		label = selectLabel(c.Placeholder, "Select a user", nil)
+		disabled = c.Disabled
+	case *discord.RoleSelectComponent:
+		label = selectLabel(c.Placeholder, "Select a role", nil)
+		disabled = c.Disabled
+	case *discord.MentionableSelectComponent:
+		label = selectLabel(c.Placeholder, "Select a user or role", nil)
+		disabled = c.Disabled
+	case *discord.ChannelSelectComponent:
+		label = selectLabel(c.Placeholder, "Select a channel", nil)
+		disabled = c.Disabled
+	default:
+		io.WriteString(w, "[::d](unsupported component)[::D]")
+		return
+	}
+
+	switch {
+	case disabled && focused:
+		fmt.Fprintf(w, "[::dsu] %s [::DSU]", label)
+	case disabled:
+		fmt.Fprintf(w, "[::ds] %s [::DS]", label)
+	case focused:
+		fmt.Fprintf(w, "[%s::rbu] %s [-::RBU]", color, label)
+	default:
+		fmt.Fprintf(w, "[%s::r] %s [-::R]", color, label)
+	}
+}
+
+// selectLabel returns the label of a select menu, which shows its selected
+// options or else its placeholder.
+func selectLabel(placeholder, defaultPlaceholder string, selected []string) string {
+	label := placeholder
+	if len(selected) > 0 {
+		label = strings.Join(selected, ", ")
+	} else if label == "" {
+		label = defaultPlaceholder
+	}
+
+	return tview.Escape(label) + " ▾"
+}
+
+// drawThreadIndicator draws the name and reply count of the thread started
+// from the message, if any.
+func (ml *messagesList) drawThreadIndicator(w io.Writer, message discord.Message) {
+	if message.Flags&discord.MessageHasThread == 0 {
+		return
+	}
+
+	// The thread has the same ID as the message it was started from.
+	thread, err := discordState.Cabinet.Channel(discord.ChannelID(message.ID))
+	if err != nil {
+		fmt.Fprintf(w, "\n[::d]%s thread[::D]", ml.cfg.Theme.MessagesList.ThreadIndicator)
+		return
+	}
+
+	replies := "replies"
+	if thread.MessageCount == 1 {
+		replies = "reply"
+	}
+
+	fmt.Fprintf(w, "\n[::d]%s %s (%d %s)[::D]", ml.cfg.Theme.MessagesList.ThreadIndicator, tview.Escape(thread.Name), thread.MessageCount, replies)
+}
+
+func (ml *messagesList) drawReactions(w io.Writer, messageID discord.MessageID, reactions []discord.Reaction) {
 	if len(reactions) == 0 {
 		return
 	}
@@ -233,6 +1025,7 @@ func (ml *messagesList) drawReactions(w io.Writer, reactions []discord.Reaction)
 	// Get style
 	fg := ml.cfg.Theme.MessagesList.ReactionStyle.GetForeground()
 	bg := ml.cfg.Theme.MessagesList.ReactionStyle.GetBackground()
+	style := fmt.Sprintf("[%s:%s]", fg, bg)
 
 	// Build reaction string
 	var parts []string
@@ -242,6 +1035,13 @@ func (ml *messagesList) drawReactions(w io.Writer, reactions []discord.Reaction)
 			emoji = r.Emoji.Name
 		} else {
 			emoji = ":" + r.Emoji.Name + ":"
+			if ml.previews != nil {
+				custom := &discordmd.Emoji{ID: r.Emoji.ID.String(), Name: r.Emoji.Name, GIF: r.Emoji.Animated}
+				if text, ok := ml.previews.emoji(messageID, custom); ok {
+					// The image resets the style.
+					emoji = text + style
+				}
+			}
 		}
 
 		part := fmt.Sprintf("%s %d", emoji, r.Count)
@@ -252,10 +1052,10 @@ func (ml *messagesList) drawReactions(w io.Writer, reactions []discord.Reaction)
 	}
 
 	reactionStr := strings.Join(parts, " | ")
-	fmt.Fprintf(w, "[%s:%s]%s[-:-]", fg, bg, reactionStr)
+	fmt.Fprintf(w, "%s%s[-:-]", style, reactionStr)
 }
 
-func (ml *messagesList) drawEmbed(w io.Writer, embed discord.Embed) {
+func (ml *messagesList) drawEmbed(w io.Writer, messageID discord.MessageID, embed discord.Embed) {
 	fmt.Fprintln(w)
 
 	// Draw embed border indicator
@@ -303,6 +1103,11 @@ func (ml *messagesList) drawEmbed(w io.Writer, embed discord.Embed) {
 		fg := ml.cfg.Theme.MessagesList.URLStyle.GetForeground()
 		bg := ml.cfg.Theme.MessagesList.URLStyle.GetBackground()
 		fmt.Fprintf(w, "\n[::d]│[::D] [%s:%s]Image: %s[-:-]", fg, bg, embed.Image.URL)
+
+		if ml.previews != nil {
+			url := ml.previews.url(embed.Image.Proxy, embed.Image.URL, embed.Image.Width, embed.Image.Height)
+			ml.drawImagePreview(w, messageID, url, "[::d]│[::D] ")
+		}
 	}
 
 	// Embed thumbnail URL
@@ -310,6 +1115,62 @@ func (ml *messagesList) drawEmbed(w io.Writer, embed discord.Embed) {
 		fg := ml.cfg.Theme.MessagesList.URLStyle.GetForeground()
 		bg := ml.cfg.Theme.MessagesList.URLStyle.GetBackground()
 		fmt.Fprintf(w, "\n[::d]│[::D] [%s:%s]Thumbnail: %s[-:-]", fg, bg, embed.Thumbnail.URL)
+
+		if ml.previews != nil {
+			url := ml.previews.url(embed.Thumbnail.Proxy, embed.Thumbnail.URL, embed.Thumbnail.Width, embed.Thumbnail.Height)
+			ml.drawImagePreview(w, messageID, url, "[::d]│[::D] ")
+		}
+	}
+}
+
+// drawImagePreview draws the preview of the image on the lines below, each
+// after the prefix, once it is loaded. Previews are not wider than the list so
+// that they are not wrapped.
+func (ml *messagesList) drawImagePreview(w io.Writer, messageID discord.MessageID, url string, prefix string) {
+	maxCols := int(ml.cfg.ImagePreviews.MaxWidth)
+	if _, _, width, _ := ml.GetInnerRect(); width > 0 {
+		maxCols = min(maxCols, width-tview.TaggedStringWidth(prefix))
+	}
+
+	lines, ok := ml.previews.lines(messageID, url, maxCols, int(ml.cfg.ImagePreviews.MaxHeight))
+	if !ok {
+		return
+	}
+
+	for _, line := range lines {
+		io.WriteString(w, "\n"+prefix+line)
+	}
+}
+
+// Draw renders the segments again if the width of the list changed since they
+// were rendered, as the image previews are scaled to it, and draws the list.
+func (ml *messagesList) Draw(screen tcell.Screen) {
+	if _, _, width, _ := ml.GetInnerRect(); ml.previews != nil && width != ml.previewsWidth {
+		ml.previewsWidth = width
+		clear(ml.segments)
+		ml.redraw()
+	}
+
+	ml.TextView.Draw(screen)
+}
+
+// drawImagePreviews draws the images of the previews over the list with the
+// graphics protocol of the terminal. It has to be called after everything else
+// is drawn, since the images are drawn over the cells that are on the screen.
+func (ml *messagesList) drawImagePreviews(screen tcell.Screen) {
+	if ml.previews == nil || ml.previews.overlay == nil {
+		return
+	}
+
+	x, y, width, height := ml.GetInnerRect()
+	ml.previews.overlay.Draw(screen, x, y, width, height)
+}
+
+// resetImagePreviews draws the images of the previews again on the next draw,
+// e.g. after the terminal was used by another program.
+func (ml *messagesList) resetImagePreviews() {
+	if ml.previews != nil && ml.previews.overlay != nil {
+		ml.previews.overlay.Reset()
 	}
 }
 
@@ -337,39 +1198,84 @@ func (ml *messagesList) drawReplyMessage(w io.Writer, message discord.Message) {
 	ml.drawDefaultMessage(w, message)
 }
 
+// drawCommandMessage draws the response of an application to a command, with
+// who invoked it. Ephemeral responses are only seen by the current user, and
+// deferred ones are loading until the application edits them.
+func (ml *messagesList) drawCommandMessage(w io.Writer, message discord.Message) {
+	if i := message.Interaction; i != nil {
+		fmt.Fprintf(w, "[::d]%s %s used /%s", ml.cfg.Theme.MessagesList.ReplyIndicator, tview.Escape(i.User.DisplayOrUsername()), tview.Escape(i.Name))
+		if message.Flags&discord.EphemeralMessage != 0 {
+			io.WriteString(w, " (only visible to you)")
+		}
+		io.WriteString(w, "[::D]\n")
+	}
+
+	if message.Flags&discord.MessageLoading != 0 {
+		if ml.cfg.Timestamps.Enabled {
+			ml.drawTimestamps(w, message.Timestamp)
+		}
+		ml.drawAuthor(w, message)
+		io.WriteString(w, "[::d]is thinking…[::D]")
+		return
+	}
+
+	ml.drawDefaultMessage(w, message)
+}
+
 func (ml *messagesList) drawPinnedMessage(w io.Writer, message discord.Message) {
 	fmt.Fprintf(w, "%s pinned a message", message.Author.DisplayOrUsername())
 }
 
+func (ml *messagesList) drawThreadCreatedMessage(w io.Writer, message discord.Message) {
+	ml.drawTimestamps(w, message.Timestamp)
+	ml.drawAuthor(w, message)
+	fmt.Fprintf(w, "started a thread: [::b]%s[::B]", tview.Escape(message.Content))
+}
+
+// drawThreadStarterMessage draws the first message of a thread, which refers
+// to the message of the parent channel that the thread was started from.
+func (ml *messagesList) drawThreadStarterMessage(w io.Writer, message discord.Message) {
+	fmt.Fprintf(w, "[::d]%s ", ml.cfg.Theme.MessagesList.ThreadIndicator)
+	if m := message.ReferencedMessage; m != nil {
+		m.GuildID = message.GuildID
+		ml.drawAuthor(w, *m)
+		ml.drawContent(w, *m)
+	} else {
+		io.WriteString(w, "Original message was deleted")
+	}
+
+	io.WriteString(w, "[::D]")
+}
+
 func (ml *messagesList) selectedMessage() (*discord.Message, error) {
 	if !ml.selectedMessageID.IsValid() {
 		return nil, errors.New("no message is currently selected")
 	}
 
-	m, err := discordState.Cabinet.Message(app.chatView.selectedChannel.ID, ml.selectedMessageID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve selected message: %w", err)
+	i := ml.messageIndex(ml.selectedMessageID)
+	if i == -1 {
+		return nil, fmt.Errorf("failed to retrieve selected message: %s is not loaded", ml.selectedMessageID)
 	}
 
-	return m, nil
+	m := ml.messages[i]
+	return &m, nil
 }
 
-func (ml *messagesList) selectedMessageIndex() (int, error) {
-	ms, err := discordState.Cabinet.Messages(app.chatView.selectedChannel.ID)
-	if err != nil {
-		return -1, err
-	}
+// messageIndex returns the index of the message in the loaded window, or -1 if
+// it is not loaded.
+func (ml *messagesList) messageIndex(id discord.MessageID) int {
+	return slices.IndexFunc(ml.messages, func(m discord.Message) bool {
+		return m.ID == id
+	})
+}
 
-	for i, m := range ms {
-		if m.ID == ml.selectedMessageID {
-			return i, nil
+func (ml *messagesList) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	if ml.focusedComponent != -1 {
+		if event = ml.onComponentsInputCapture(event); event == nil {
+			return nil
 		}
 	}
 
-	return -1, nil
-}
-
-func (ml *messagesList) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Name() {
 	case ml.cfg.Keys.MessagesList.Cancel:
 		ml.selectedMessageID = 0
@@ -387,10 +1293,20 @@ func (ml *messagesList) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
 		ml.open()
 	case ml.cfg.Keys.MessagesList.AddReaction:
 		ml.showReactionPicker()
+	case ml.cfg.Keys.MessagesList.ShowReactions:
+		ml.showReactors()
+	case ml.cfg.Keys.MessagesList.Forward:
+		ml.showForwardPicker()
 	case ml.cfg.Keys.MessagesList.PinMessage:
 		ml.pinMessage()
 	case ml.cfg.Keys.MessagesList.UnpinMessage:
 		ml.unpinMessage()
+	case ml.cfg.Keys.MessagesList.Jump:
+		ml.jump()
+	case ml.cfg.Keys.MessagesList.OpenThread:
+		ml.openThread()
+	case ml.cfg.Keys.MessagesList.CreateThread:
+		ml.showCreateThread()
 	case ml.cfg.Keys.MessagesList.Reply:
 		ml.reply(false)
 	case ml.cfg.Keys.MessagesList.ReplyMention:
@@ -401,28 +1317,52 @@ func (ml *messagesList) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
 		ml.delete()
 	case ml.cfg.Keys.MessagesList.DeleteConfirm:
 		ml.confirmDelete()
+	case ml.cfg.Keys.MessagesList.RetrySend:
+		ml.retrySend()
+	case ml.cfg.Keys.MessagesList.JumpToUnread:
+		ml.jumpToFirstUnread()
+	case ml.cfg.Keys.MessagesList.RevealSpoilers:
+		ml.toggleSpoilers()
+	case ml.cfg.Keys.MessagesList.VotePoll:
+		ml.showPollVote()
+	case ml.cfg.Keys.MessagesList.Export:
+		ml.showExport()
+	case ml.cfg.Keys.MessagesList.FocusComponents:
+		ml.focusComponents()
 	}
 
 	return nil
 }
 
-func (ml *messagesList) _select(name string) {
-	if app.chatView.selectedChannel == nil {
-		return
+func (ml *messagesList) onMouseCapture(action tview.MouseAction, event *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	switch action {
+	case tview.MouseScrollUp:
+		if row, _ := ml.GetScrollOffset(); row <= 0 {
+			ml.loadOlderMessages()
+		}
+	case tview.MouseScrollDown:
+		row, _ := ml.GetScrollOffset()
+		_, _, _, height := ml.GetInnerRect()
+		if row+height >= ml.GetWrappedLineCount() {
+			ml.loadNewerMessages()
+		}
 	}
 
-	ms, err := discordState.Cabinet.Messages(app.chatView.selectedChannel.ID)
-	if err != nil {
-		slog.Error("failed to get messages", "err", err, "channel_id", app.chatView.selectedChannel.ID)
+	return action, event
+}
+
+func (ml *messagesList) _select(name string) {
+	if app.chatView.selectedChannel == nil {
 		return
 	}
 
-	msgIdx, err := ml.selectedMessageIndex()
-	if err != nil {
-		slog.Error("failed to get selected message", "err", err)
+	ms := ml.messages
+	if len(ms) == 0 {
 		return
 	}
 
+	msgIdx := ml.messageIndex(ml.selectedMessageID)
+
 	switch name {
 	case ml.cfg.Keys.MessagesList.SelectPrevious:
 		// If no message is currently selected, select the latest message.
@@ -431,6 +1371,8 @@ func (ml *messagesList) _select(name string) {
 		} else if msgIdx < len(ms)-1 {
 			ml.selectedMessageID = ms[msgIdx+1].ID
 		} else {
+			// The oldest loaded message is selected; fetch the previous page.
+			ml.loadOlderMessages()
 			return
 		}
 	case ml.cfg.Keys.MessagesList.SelectNext:
@@ -440,14 +1382,23 @@ func (ml *messagesList) _select(name string) {
 		} else if msgIdx > 0 {
 			ml.selectedMessageID = ms[msgIdx-1].ID
 		} else {
+			// The latest loaded message is selected; fetch the next page.
+			ml.loadNewerMessages()
 			return
 		}
 	case ml.cfg.Keys.MessagesList.SelectFirst:
 		ml.selectedMessageID = ms[len(ms)-1].ID
 	case ml.cfg.Keys.MessagesList.SelectLast:
+		// Load the latest messages again instead of paging through the
+		// messages in between.
+		if !ml.history.reachedEnd {
+			app.chatView.openChannel(app.chatView.selectedChannel)
+			return
+		}
+
 		ml.selectedMessageID = ms[0].ID
 	case ml.cfg.Keys.MessagesList.SelectReply:
-		if ml.selectedMessageID == 0 {
+		if msgIdx == -1 {
 			return
 		}
 
@@ -472,6 +1423,16 @@ func (ml *messagesList) onHighlighted(added, removed, remaining []string) {
 			return
 		}
 
+		// Selecting another message, e.g. with the mouse, stops focusing the
+		// components of the previous one.
+		if ml.focusedComponent != -1 && discord.MessageID(id) != ml.selectedMessageID {
+			ml.focusedComponent = -1
+			delete(ml.segments, ml.selectedMessageID)
+			ml.selectedMessageID = discord.MessageID(id)
+			ml.redraw()
+			return
+		}
+
 		ml.selectedMessageID = discord.MessageID(id)
 	}
 }
@@ -585,6 +1546,57 @@ func extractURLs(content string) []string {
 	return urls
 }
 
+var messageLinkRegex = regexp.MustCompile(`^https?://(?:(?:ptb|canary)\.)?discord(?:app)?\.com/channels/(?:@me|\d+)/(\d+)/(\d+)`)
+
+// parseMessageLink returns the channel and message IDs of a
+// discord.com/channels/... message link.
+func parseMessageLink(url string) (discord.ChannelID, discord.MessageID, bool) {
+	matches := messageLinkRegex.FindStringSubmatch(url)
+	if matches == nil {
+		return 0, 0, false
+	}
+
+	channelID, err := discord.ParseSnowflake(matches[1])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	messageID, err := discord.ParseSnowflake(matches[2])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return discord.ChannelID(channelID), discord.MessageID(messageID), true
+}
+
+// jump jumps to the message that the selected message refers to: the message
+// it replies to, forwards or announces as pinned, or else the first message
+// link in its content.
+func (ml *messagesList) jump() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	if ref := msg.Reference; ref != nil && ref.MessageID.IsValid() {
+		channelID := ref.ChannelID
+		if !channelID.IsValid() {
+			channelID = msg.ChannelID
+		}
+
+		app.chatView.jumpToMessage(channelID, ref.MessageID, nil)
+		return
+	}
+
+	for _, url := range extractURLs(msg.Content) {
+		if channelID, messageID, ok := parseMessageLink(url); ok {
+			app.chatView.jumpToMessage(channelID, messageID, nil)
+			return
+		}
+	}
+}
+
 func (ml *messagesList) showAttachmentsList(urls []string, attachments []discord.Attachment) {
 	closeModal := func() {
 		app.chatView.RemovePage(attachmentsListPageName).SwitchToPage(flexPageName)
@@ -648,134 +1660,145 @@ func (ml *messagesList) showReactionPicker() {
 		return
 	}
 
-	emojis := []struct {
-		emoji string
-		name  string
-	}{
-		{"👍", "thumbs up"},
-		{"👎", "thumbs down"},
-		{"❤️", "heart"},
-		{"😂", "laughing"},
-		{"😮", "surprised"},
-		{"😢", "sad"},
-		{"😡", "angry"},
-		{"🎉", "party"},
-		{"🔥", "fire"},
-		{"✅", "check mark"},
-		{"❌", "cross mark"},
-		{"🤔", "thinking"},
-		{"👀", "eyes"},
-		{"💯", "hundred"},
-		{"😊", "smiling"},
-		{"🙏", "pray"},
-		{"💀", "skull"},
-		{"⭐", "star"},
-		{"💔", "broken heart"},
-		{"🤣", "rofl"},
-	}
-
-	closeModal := func() {
+	message := *msg
+	closePicker := func() {
 		app.chatView.RemovePage(reactionPickerPageName).SwitchToPage(flexPageName)
 		app.SetFocus(ml)
 	}
 
-	list := tview.NewList().
-		SetWrapAround(true).
-		SetHighlightFullLine(true).
-		ShowSecondaryText(false).
-		SetDoneFunc(closeModal)
-
-	list.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		switch event.Name() {
-		case ml.cfg.Keys.MessagesList.SelectPrevious:
-			return tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone)
-		case ml.cfg.Keys.MessagesList.SelectNext:
-			return tcell.NewEventKey(tcell.KeyDown, "", tcell.ModNone)
-		case ml.cfg.Keys.MessagesList.SelectFirst:
-			return tcell.NewEventKey(tcell.KeyHome, "", tcell.ModNone)
-		case ml.cfg.Keys.MessagesList.SelectLast:
-			return tcell.NewEventKey(tcell.KeyEnd, "", tcell.ModNone)
-		case "Esc", ml.cfg.Keys.MessagesList.Cancel:
-			closeModal()
-			return nil
+	picker := newReactionPicker(ml.cfg, message)
+	picker.onDone = closePicker
+	picker.onSelected = func(emoji discord.Emoji) {
+		closePicker()
+		if _, ok := ownReaction(message, emoji); !ok {
+			app.recentEmoji.use(emoji)
 		}
-		return event
-	})
+		go ml.toggleReaction(message, emoji)
+	}
 
-	list.Box = ui.ConfigureBox(list.Box, &ml.cfg.Theme)
-	list.SetTitle("Add Reaction")
+	app.chatView.
+		AddAndSwitchToPage(reactionPickerPageName, ui.Centered(picker, 50, 24), true).
+		ShowPage(flexPageName)
+}
 
-	for i, e := range emojis {
-		emoji := e.emoji
-		itemText := fmt.Sprintf("%s  %s", emoji, e.name)
+func (ml *messagesList) showReactors() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message for reactions", "err", err)
+		return
+	}
 
-		// Only assign number shortcuts for first 9 items
-		var shortcut rune
-		if i < 9 {
-			shortcut = rune('1' + i)
-		}
+	if len(msg.Reactions) == 0 {
+		return
+	}
 
-		list.AddItem(itemText, "", shortcut, func() {
-			closeModal()
-			go ml.toggleReaction(msg, emoji)
-		})
+	rl := newReactorsList(ml.cfg, *msg)
+	rl.onDone = func() {
+		app.chatView.RemovePage(reactorsListPageName).SwitchToPage(flexPageName)
+		app.SetFocus(ml)
 	}
 
 	app.chatView.
-		AddAndSwitchToPage(reactionPickerPageName, ui.Centered(list, 35, 24), true).
+		AddAndSwitchToPage(reactorsListPageName, ui.Centered(rl, 60, 20), true).
 		ShowPage(flexPageName)
 }
 
-func (ml *messagesList) toggleReaction(msg *discord.Message, emoji string) {
-	apiEmoji := discord.APIEmoji(emoji)
+func (ml *messagesList) showForwardPicker() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message for forwarding", "err", err)
+		return
+	}
 
-	// Check if already reacted
-	alreadyReacted := false
-	for _, r := range msg.Reactions {
-		if r.Emoji.Name == emoji && r.Me {
-			alreadyReacted = true
-			break
-		}
+	// Messages that have not been sent yet cannot be referenced.
+	if isLocalMessage(*msg) {
+		return
 	}
 
-	var err error
-	if alreadyReacted {
-		err = discordState.Unreact(msg.ChannelID, msg.ID, apiEmoji)
-		if err != nil {
-			slog.Error("failed to remove reaction", "err", err, "emoji", emoji)
-		}
-	} else {
-		err = discordState.React(msg.ChannelID, msg.ID, apiEmoji)
-		if err != nil {
-			slog.Error("failed to add reaction", "err", err, "emoji", emoji)
-		}
+	message := *msg
+	closePicker := func() {
+		app.chatView.RemovePage(forwardPickerPageName).SwitchToPage(flexPageName)
+		app.SetFocus(ml)
+	}
+
+	picker := newForwardPicker(ml.cfg)
+	picker.onDone = closePicker
+	picker.onSelected = func(channel discord.Channel, comment string) {
+		closePicker()
+		go ml.forward(message, channel, comment)
 	}
+
+	app.chatView.
+		AddAndSwitchToPage(forwardPickerPageName, ui.Centered(picker, 60, 24), true).
+		ShowPage(flexPageName)
 }
 
-func (ml *messagesList) openAttachment(attachment discord.Attachment) {
-	resp, err := http.Get(attachment.URL)
-	if err != nil {
-		slog.Error("failed to fetch the attachment", "err", err, "url", attachment.URL)
+// forward forwards the message to the channel and queues the comment to be
+// sent after it, like the official client does.
+func (ml *messagesList) forward(message discord.Message, channel discord.Channel, comment string) {
+	sb := app.chatView.statusBar
+	if _, err := forwardMessage(channel.ID, message); err != nil {
+		slog.Error("failed to forward message", "err", err, "channel_id", channel.ID, "message_id", message.ID)
+		sb.setTaskResult(fmt.Sprintf("[red]failed to forward message: %s[-]", tview.Escape(err.Error())))
 		return
 	}
-	defer resp.Body.Close()
 
-	path := filepath.Join(consts.CacheDir(), "attachments")
-	if err := os.MkdirAll(path, os.ModePerm); err != nil {
-		slog.Error("failed to create attachments dir", "err", err, "path", path)
+	if comment == "" {
 		return
 	}
 
-	path = filepath.Join(path, attachment.Filename)
-	file, err := os.Create(path)
+	local, err := app.outbox.add(channel, api.SendMessageData{Content: comment})
 	if err != nil {
-		slog.Error("failed to create attachment file", "err", err, "path", path)
+		slog.Error("failed to queue forward comment", "err", err, "channel_id", channel.ID)
+		sb.setTaskResult("[red]failed to send forward comment[-]")
+		return
+	}
+
+	// The comment may have been sent already, replacing its local message.
+	app.QueueUpdateDraw(func() {
+		if _, ok := app.outbox.entry(local.ID); !ok {
+			return
+		}
+
+		if selected := app.chatView.selectedChannel; selected != nil && selected.ID == channel.ID {
+			ml.appendMessage(local)
+		}
+	})
+}
+
+// ownReaction returns the reaction of the current user to the message with the
+// emoji.
+func ownReaction(msg discord.Message, emoji discord.Emoji) (discord.Reaction, bool) {
+	key := emojiKey(emoji)
+	for _, r := range msg.Reactions {
+		if r.Me && emojiKey(r.Emoji) == key {
+			return r, true
+		}
+	}
+	return discord.Reaction{}, false
+}
+
+func (ml *messagesList) toggleReaction(msg discord.Message, emoji discord.Emoji) {
+	// Remove the reaction with the emoji as Discord knows it, which may differ
+	// in its variation selectors.
+	if r, ok := ownReaction(msg, emoji); ok {
+		apiEmoji := r.Emoji.APIString()
+		if err := discordState.Unreact(msg.ChannelID, msg.ID, apiEmoji); err != nil {
+			slog.Error("failed to remove reaction", "err", err, "emoji", apiEmoji)
+		}
 		return
 	}
-	defer file.Close()
 
-	if _, err := io.Copy(file, resp.Body); err != nil {
-		slog.Error("failed to copy attachment to file", "err", err)
+	apiEmoji := emoji.APIString()
+	if err := discordState.React(msg.ChannelID, msg.ID, apiEmoji); err != nil {
+		slog.Error("failed to add reaction", "err", err, "emoji", apiEmoji)
+	}
+}
+
+func (ml *messagesList) openAttachment(attachment discord.Attachment) {
+	path, err := app.media.Get(context.Background(), attachment.URL)
+	if err != nil {
+		slog.Error("failed to download the attachment", "err", err, "url", attachment.URL)
 		return
 	}
 
@@ -786,6 +1809,13 @@ func (ml *messagesList) openAttachment(attachment discord.Attachment) {
 }
 
 func (ml *messagesList) openURL(url string) {
+	if channelID, messageID, ok := parseMessageLink(url); ok {
+		app.QueueUpdateDraw(func() {
+			app.chatView.jumpToMessage(channelID, messageID, nil)
+		})
+		return
+	}
+
 	if err := open.Start(url); err != nil {
 		slog.Error("failed to open URL", "err", err, "url", url)
 	}
@@ -844,10 +1874,28 @@ func (ml *messagesList) edit() {
 		return
 	}
 
-	app.chatView.messageInput.SetTitle("Editing")
-	app.chatView.messageInput.edit = true
-	app.chatView.messageInput.SetText(message.Content, true)
-	app.SetFocus(app.chatView.messageInput)
+	app.chatView.messageInput.editMessage(*message)
+}
+
+// editLast selects the latest loaded message of the current user and edits it.
+// It reports whether there is one.
+func (ml *messagesList) editLast() bool {
+	me, err := discordState.Cabinet.Me()
+	if err != nil {
+		slog.Error("failed to get client user (me)", "err", err)
+		return false
+	}
+
+	i := slices.IndexFunc(ml.messages, func(m discord.Message) bool {
+		return m.Author.ID == me.ID && !isLocalMessage(m) && (m.Type == discord.DefaultMessage || m.Type == discord.InlinedReplyMessage)
+	})
+	if i == -1 {
+		return false
+	}
+
+	ml.selectMessage(ml.messages[i].ID)
+	app.chatView.messageInput.editMessage(ml.messages[i])
+	return true
 }
 
 func (ml *messagesList) confirmDelete() {
@@ -871,6 +1919,14 @@ func (ml *messagesList) delete() {
 		return
 	}
 
+	// Messages that are not sent yet are discarded.
+	if isLocalMessage(*msg) {
+		app.outbox.remove(msg.ID)
+		ml.selectedMessageID = 0
+		ml.deleteMessage(msg.ID)
+		return
+	}
+
 	if msg.GuildID.IsValid() {
 		me, err := discordState.Cabinet.Me()
 		if err != nil {
@@ -901,6 +1957,118 @@ func (ml *messagesList) delete() {
 	// its work after the event returns
 }
 
+// retrySend sends the selected message again if it failed to be sent.
+func (ml *messagesList) retrySend() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	if e, ok := app.outbox.entry(msg.ID); !ok || !e.Failed {
+		return
+	}
+
+	app.outbox.retry(msg.ID)
+	ml.redrawMessage(msg.ID)
+}
+
+// toggleSpoilers shows the spoilers of the selected message, or hides them
+// again.
+func (ml *messagesList) toggleSpoilers() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	if ml.revealedSpoilers[msg.ID] {
+		delete(ml.revealedSpoilers, msg.ID)
+	} else {
+		ml.revealedSpoilers[msg.ID] = true
+	}
+
+	ml.redrawMessage(msg.ID)
+}
+
+// showPollVote shows the answers of the poll of the selected message to vote
+// for. Only one answer can be checked unless the poll allows multiple.
+func (ml *messagesList) showPollVote() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	p, ok := app.polls.poll(msg.ID)
+	if !ok || p.closed() {
+		return
+	}
+
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(pollVotePageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	checkboxes := make([]*tview.Checkbox, len(p.Answers))
+	for i, answer := range p.Answers {
+		_, voted := p.count(answer.AnswerID)
+		checkboxes[i] = tview.NewCheckbox().
+			SetLabel(pollMediaText(answer.PollMedia)).
+			SetChecked(voted)
+		checkboxes[i].SetChangedFunc(func(checked bool) {
+			if !checked || p.AllowMultiselect {
+				return
+			}
+
+			for j, c := range checkboxes {
+				if j != i {
+					c.SetChecked(false)
+				}
+			}
+		})
+		form.AddFormItem(checkboxes[i])
+	}
+
+	form.AddButton("Vote", func() {
+		var answerIDs []int
+		for i, c := range checkboxes {
+			if c.IsChecked() {
+				answerIDs = append(answerIDs, p.Answers[i].AnswerID)
+			}
+		}
+
+		closeForm()
+		go ml.votePoll(*msg, answerIDs)
+	})
+	form.AddButton("Remove Vote", func() {
+		closeForm()
+		go ml.votePoll(*msg, nil)
+	})
+	form.AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+
+	title := "Vote"
+	if p.AllowMultiselect {
+		title += " (multiple answers)"
+	}
+
+	form.Box = ui.ConfigureBox(form.Box, &ml.cfg.Theme)
+	form.SetTitle(title)
+
+	app.chatView.AddAndSwitchToPage(pollVotePageName, ui.Centered(form, 60, len(p.Answers)*2+5), true).
+		ShowPage(flexPageName)
+}
+
+func (ml *messagesList) votePoll(msg discord.Message, answerIDs []int) {
+	// The votes are shown once the vote events are received.
+	if err := votePoll(msg.ChannelID, msg.ID, answerIDs); err != nil {
+		slog.Error("failed to vote in poll", "err", err, "channel_id", msg.ChannelID, "message_id", msg.ID)
+	}
+}
+
 func (ml *messagesList) pinMessage() {
 	msg, err := ml.selectedMessage()
 	if err != nil {
@@ -932,16 +2100,9 @@ func (ml *messagesList) pinMessage() {
 			slog.Error("failed to update message in state", "err", err)
 		}
 
-		// Refresh the message list to show the pinned indicator
-		messages, err := discordState.Cabinet.Messages(msg.ChannelID)
-		if err != nil {
-			slog.Error("failed to get messages after pin", "err", err)
-			return
-		}
-
+		// Re-render the message to show the pinned indicator
 		app.QueueUpdateDraw(func() {
-			ml.reset()
-			ml.drawMessages(messages)
+			ml.updateMessage(*msg)
 		})
 	}()
 }
@@ -977,20 +2138,125 @@ func (ml *messagesList) unpinMessage() {
 			slog.Error("failed to update message in state", "err", err)
 		}
 
-		// Refresh the message list to show the pinned indicator removed
-		messages, err := discordState.Cabinet.Messages(msg.ChannelID)
+		// Re-render the message to show the pinned indicator removed
+		app.QueueUpdateDraw(func() {
+			ml.updateMessage(*msg)
+		})
+	}()
+}
+
+// openThread opens the thread that was started from the selected message, or
+// the thread that a "started a thread" message refers to.
+func (ml *messagesList) openThread() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	var threadID discord.ChannelID
+	switch {
+	case msg.Type == discord.ThreadCreatedMessage && msg.Reference != nil:
+		threadID = msg.Reference.ChannelID
+	case msg.Flags&discord.MessageHasThread != 0:
+		// The thread has the same ID as the message it was started from.
+		threadID = discord.ChannelID(msg.ID)
+	default:
+		return
+	}
+
+	go func() {
+		thread, err := discordState.Channel(threadID)
 		if err != nil {
-			slog.Error("failed to get messages after unpin", "err", err)
+			slog.Error("failed to get thread", "err", err, "thread_id", threadID)
 			return
 		}
 
 		app.QueueUpdateDraw(func() {
-			ml.reset()
-			ml.drawMessages(messages)
+			app.chatView.guildsTree.addThreadNode(*thread)
+			app.chatView.openChannel(thread)
 		})
 	}()
 }
 
+// maxThreadNameLength is the maximum length of a thread name allowed by
+// Discord.
+const maxThreadNameLength = 100
+
+func (ml *messagesList) showCreateThread() {
+	msg, err := ml.selectedMessage()
+	if err != nil {
+		slog.Error("failed to get selected message", "err", err)
+		return
+	}
+
+	if msg.Flags&discord.MessageHasThread != 0 {
+		ml.openThread()
+		return
+	}
+
+	channel := app.chatView.selectedChannel
+	if channel == nil || (channel.Type != discord.GuildText && channel.Type != discord.GuildAnnouncement) {
+		return
+	}
+
+	if !discordState.HasPermissions(channel.ID, discord.PermissionCreatePublicThreads) {
+		slog.Error("failed to create thread; missing CreatePublicThreads permission", "channel_id", channel.ID)
+		return
+	}
+
+	name := strings.TrimSpace(strings.SplitN(msg.Content, "\n", 2)[0])
+	if runes := []rune(name); len(runes) > maxThreadNameLength {
+		name = string(runes[:maxThreadNameLength])
+	}
+	if name == "" {
+		name = msg.Author.DisplayOrUsername()
+	}
+
+	previousFocus := app.GetFocus()
+	form := tview.NewForm()
+	form.AddInputField("Name:", name, 40, func(text string) {
+		name = text
+	})
+	form.AddButton("Create", func() {
+		name := strings.TrimSpace(name)
+		if name == "" {
+			return
+		}
+		app.chatView.RemovePage(createThreadPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+		go ml.createThread(*msg, name)
+	})
+	form.AddButton("Cancel", func() {
+		app.chatView.RemovePage(createThreadPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	})
+
+	form.Box = ui.ConfigureBox(form.Box, &ml.cfg.Theme)
+	form.SetTitle("Create Thread")
+
+	app.chatView.AddAndSwitchToPage(createThreadPageName, ui.Centered(form, 60, 10), true).
+		ShowPage(flexPageName)
+}
+
+func (ml *messagesList) createThread(msg discord.Message, name string) {
+	thread, err := discordState.StartThreadWithMessage(msg.ChannelID, msg.ID, api.StartThreadData{
+		Name:                name,
+		AutoArchiveDuration: discord.OneDayArchive,
+	})
+	if err != nil {
+		slog.Error("failed to create thread", "err", err, "channel_id", msg.ChannelID, "message_id", msg.ID)
+		return
+	}
+
+	slog.Info("created thread", "thread_id", thread.ID, "name", thread.Name)
+
+	app.QueueUpdateDraw(func() {
+		app.chatView.guildsTree.addThreadNode(*thread)
+		app.chatView.openChannel(thread)
+	})
+}
+
 func (ml *messagesList) requestGuildMembers(gID discord.GuildID, ms []discord.Message) {
 	usersToFetch := make([]discord.UserID, 0, len(ms))
 	for _, m := range ms {
@@ -1048,3 +2314,75 @@ func (ml *messagesList) waitForChunkEvent() uint {
 	<-ml.fetchingMembers.done
 	return ml.fetchingMembers.count
 }
+
+// showExport shows the form that exports the history of the channel in the
+// background, with its progress in the status bar.
+func (ml *messagesList) showExport() {
+	channel := app.chatView.selectedChannel
+	if channel == nil {
+		return
+	}
+
+	channelID := channel.ID
+	previousFocus := app.GetFocus()
+	closeForm := func() {
+		app.chatView.RemovePage(exportPageName).SwitchToPage(flexPageName)
+		app.SetFocus(previousFocus)
+	}
+
+	form := tview.NewForm()
+	status := tview.NewTextView().SetDynamicColors(true)
+	format := tview.NewInputField().SetLabel("Format:").SetText(string(export.FormatMarkdown)).SetPlaceholder("json, markdown, html or text")
+	output := tview.NewInputField().SetLabel("Output:").SetPlaceholder(channelID.String() + ".md")
+	after := tview.NewInputField().SetLabel("After:").SetPlaceholder(exportDateLayout)
+	before := tview.NewInputField().SetLabel("Before:").SetPlaceholder(exportDateLayout)
+	authors := tview.NewInputField().SetLabel("Authors:").SetPlaceholder("IDs or names, separated by commas")
+	attachments := tview.NewCheckbox().SetLabel("Download attachments:")
+
+	form.
+		AddFormItem(format).
+		AddFormItem(output).
+		AddFormItem(after).
+		AddFormItem(before).
+		AddFormItem(authors).
+		AddFormItem(attachments).
+		AddButton("Export", func() {
+			opts, err := newExportOptions(channelID, format.GetText(), output.GetText(), after.GetText(), before.GetText(), strings.Split(authors.GetText(), ","), attachments.IsChecked())
+			if err != nil {
+				status.SetText("[red]" + tview.Escape(err.Error()) + "[-]")
+				return
+			}
+
+			closeForm()
+			go ml.export(channelID, opts)
+		}).
+		AddButton("Cancel", closeForm).
+		SetCancelFunc(closeForm)
+
+	flex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(form, 0, 1, true).
+		AddItem(status, 1, 0, false)
+	flex.Box = ui.ConfigureBox(flex.Box, &ml.cfg.Theme)
+	flex.SetTitle("Export " + ui.ChannelToString(*channel))
+
+	app.chatView.AddAndSwitchToPage(exportPageName, ui.Centered(flex, 70, 19), true).
+		ShowPage(flexPageName)
+}
+
+func (ml *messagesList) export(channelID discord.ChannelID, opts export.Options) {
+	sb := app.chatView.statusBar
+	opts.Progress = func(fetched int) {
+		sb.setTask(fmt.Sprintf("exporting: %d messages", fetched))
+	}
+
+	n, err := runExport(context.Background(), discordState.Client, channelID, opts)
+	if err != nil {
+		slog.Error("failed to export channel", "err", err, "channel_id", channelID, "path", opts.Path)
+		sb.setTaskResult("[red]export failed[-]")
+		return
+	}
+
+	slog.Info("exported channel", "channel_id", channelID, "path", opts.Path, "messages", n)
+	sb.setTaskResult(fmt.Sprintf("exported %d messages to %s", n, tview.Escape(opts.Path)))
+}
@@ -0,0 +1,426 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/utils/httputil"
+	"github.com/diamondburned/arikawa/v3/utils/sendpart"
+)
+
+// maxSendAttempts is the number of times a message is sent before it is
+// marked as failed. minRetryDelay and maxRetryDelay bound the delay between
+// the attempts.
+const (
+	maxSendAttempts = 6
+	minRetryDelay   = 2 * time.Second
+	maxRetryDelay   = time.Minute
+)
+
+// outboxEntry is a message that has not been sent yet. It is shown in the
+// messages list as a message whose ID is a local snowflake, which is also
+// sent as the nonce so that the created message can replace it.
+type outboxEntry struct {
+	ID        discord.MessageID   `json:"id"`
+	ChannelID discord.ChannelID   `json:"channel_id"`
+	GuildID   discord.GuildID     `json:"guild_id,omitempty"`
+	Data      api.SendMessageData `json:"data"`
+	// Files are copied next to the outbox so that they can be sent again,
+	// even after a restart.
+	Files []outboxFile `json:"files,omitempty"`
+
+	Failed bool   `json:"failed,omitempty"`
+	Err    string `json:"err,omitempty"`
+}
+
+type outboxFile struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// message returns the local message that represents the entry.
+func (e outboxEntry) message() discord.Message {
+	m := discord.Message{
+		ID:        e.ID,
+		ChannelID: e.ChannelID,
+		GuildID:   e.GuildID,
+		Type:      discord.DefaultMessage,
+		Content:   e.Data.Content,
+		Timestamp: discord.NewTimestamp(e.ID.Time()),
+		Nonce:     e.Data.Nonce,
+	}
+
+	if me, err := discordState.Cabinet.Me(); err == nil {
+		m.Author = *me
+	}
+
+	if ref := e.Data.Reference; ref != nil {
+		m.Type = discord.InlinedReplyMessage
+		m.Reference = ref
+		m.ReferencedMessage, _ = discordState.Cabinet.Message(e.ChannelID, ref.MessageID)
+	}
+
+	for _, f := range e.Files {
+		m.Attachments = append(m.Attachments, discord.Attachment{Filename: f.Name, Size: uint64(f.Size)})
+	}
+
+	return m
+}
+
+// outbox queues the messages to send and sends them in order in the
+// background, retrying on network and rate limit errors. The queue is
+// persisted so that unsent messages survive a restart.
+type outbox struct {
+	mu      sync.Mutex
+	path    string
+	entries []*outboxEntry
+	wake    chan struct{}
+}
+
+func newOutbox(path string) *outbox {
+	o := &outbox{
+		path: path,
+		wake: make(chan struct{}, 1),
+	}
+
+	o.load()
+	return o
+}
+
+func (o *outbox) load() {
+	data, err := os.ReadFile(o.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Warn("failed to load outbox", "err", err)
+		}
+		return
+	}
+
+	if err := json.Unmarshal(data, &o.entries); err != nil {
+		slog.Error("failed to parse outbox", "err", err)
+	}
+}
+
+// save persists the entries. It must be called with the mutex held.
+func (o *outbox) save() {
+	if len(o.entries) == 0 {
+		if err := os.Remove(o.path); err != nil && !os.IsNotExist(err) {
+			slog.Error("failed to remove outbox", "err", err)
+		}
+		return
+	}
+
+	data, err := json.Marshal(o.entries)
+	if err != nil {
+		slog.Error("failed to marshal outbox", "err", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(o.path), 0755); err != nil {
+		slog.Error("failed to create cache directory", "err", err)
+		return
+	}
+
+	// Write to a temporary file first so that a crash cannot leave a
+	// truncated outbox behind.
+	tmp := o.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		slog.Error("failed to write outbox", "err", err)
+		return
+	}
+
+	if err := os.Rename(tmp, o.path); err != nil {
+		slog.Error("failed to write outbox", "err", err)
+	}
+}
+
+// add queues the message to be sent in the channel and returns its local
+// message. The readers of the files are consumed.
+func (o *outbox) add(channel discord.Channel, data api.SendMessageData) (discord.Message, error) {
+	e := &outboxEntry{
+		ID:        discord.MessageID(discord.NewSnowflake(time.Now())),
+		ChannelID: channel.ID,
+		GuildID:   channel.GuildID,
+		Data:      data,
+	}
+	e.Data.Nonce = e.ID.String()
+
+	for i, f := range data.Files {
+		file, err := o.storeFile(e.ID, i, f)
+		if err != nil {
+			removeOutboxFiles(e.Files)
+			return discord.Message{}, err
+		}
+
+		e.Files = append(e.Files, file)
+	}
+	e.Data.Files = nil
+
+	o.mu.Lock()
+	o.entries = append(o.entries, e)
+	o.save()
+	o.mu.Unlock()
+
+	o.notify()
+	return e.message(), nil
+}
+
+// storeFile copies the file to be sent next to the outbox.
+func (o *outbox) storeFile(id discord.MessageID, i int, f sendpart.File) (outboxFile, error) {
+	dir := filepath.Join(filepath.Dir(o.path), "outbox")
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return outboxFile{}, err
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("%s-%d", id, i))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return outboxFile{}, err
+	}
+
+	size, err := io.Copy(file, f.Reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return outboxFile{}, err
+	}
+
+	return outboxFile{Name: f.Name, Path: path, Size: size}, nil
+}
+
+func removeOutboxFiles(files []outboxFile) {
+	for _, f := range files {
+		if err := os.Remove(f.Path); err != nil && !os.IsNotExist(err) {
+			slog.Error("failed to remove outbox file", "err", err, "path", f.Path)
+		}
+	}
+}
+
+// entry returns a copy of the entry with the local message ID.
+func (o *outbox) entry(id discord.MessageID) (outboxEntry, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	i := o.index(id)
+	if i == -1 {
+		return outboxEntry{}, false
+	}
+
+	return *o.entries[i], true
+}
+
+// messages returns the local messages of the entries of the channel, ordered
+// from latest to oldest.
+func (o *outbox) messages(channelID discord.ChannelID) []discord.Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var messages []discord.Message
+	for _, e := range slices.Backward(o.entries) {
+		if e.ChannelID == channelID {
+			messages = append(messages, e.message())
+		}
+	}
+
+	return messages
+}
+
+// retry queues the failed entry to be sent again.
+func (o *outbox) retry(id discord.MessageID) {
+	o.mu.Lock()
+	if i := o.index(id); i != -1 {
+		o.entries[i].Failed = false
+		o.entries[i].Err = ""
+		o.save()
+	}
+	o.mu.Unlock()
+
+	o.notify()
+}
+
+// remove drops the entry, whether it was sent or discarded, and reports
+// whether it was queued.
+func (o *outbox) remove(id discord.MessageID) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	i := o.index(id)
+	if i == -1 {
+		return false
+	}
+
+	removeOutboxFiles(o.entries[i].Files)
+	o.entries = slices.Delete(o.entries, i, i+1)
+	o.save()
+	return true
+}
+
+func (o *outbox) index(id discord.MessageID) int {
+	return slices.IndexFunc(o.entries, func(e *outboxEntry) bool {
+		return e.ID == id
+	})
+}
+
+func (o *outbox) notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// next returns a copy of the oldest entry that has not failed.
+func (o *outbox) next() (outboxEntry, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, e := range o.entries {
+		if !e.Failed {
+			return *e, true
+		}
+	}
+
+	return outboxEntry{}, false
+}
+
+func (o *outbox) fail(id discord.MessageID, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if i := o.index(id); i != -1 {
+		o.entries[i].Failed = true
+		o.entries[i].Err = err.Error()
+		o.save()
+	}
+}
+
+// run sends the queued messages one after the other.
+func (o *outbox) run() {
+	var (
+		current  discord.MessageID
+		attempts int
+		delay    time.Duration
+	)
+	for {
+		e, ok := o.next()
+		if !ok {
+			<-o.wake
+			continue
+		}
+
+		if e.ID != current {
+			current = e.ID
+			attempts = 0
+			delay = minRetryDelay
+		}
+
+		message, err := o.send(e)
+		if err == nil {
+			if o.remove(e.ID) {
+				app.QueueUpdateDraw(func() {
+					app.chatView.messagesList.confirmMessage(e.ID, *message)
+				})
+			}
+
+			// Move the DM to the top of the DM list.
+			if channel, err := discordState.Cabinet.Channel(e.ChannelID); err == nil && (channel.Type == discord.DirectMessage || channel.Type == discord.GroupDM) {
+				go app.chatView.guildsTree.moveDMToTopOnMessage(e.ChannelID)
+			}
+			continue
+		}
+
+		attempts++
+		if attempts < maxSendAttempts && isRetryableSendError(err) {
+			slog.Warn("failed to send message; retrying", "err", err, "channel_id", e.ChannelID, "attempt", attempts, "delay", delay)
+
+			// Wake up early if the entry is discarded meanwhile.
+			select {
+			case <-time.After(delay):
+			case <-o.wake:
+			}
+
+			delay = min(delay*2, maxRetryDelay)
+			continue
+		}
+
+		slog.Error("failed to send message in channel", "channel_id", e.ChannelID, "err", err)
+		current = 0
+		o.fail(e.ID, err)
+		app.QueueUpdateDraw(func() {
+			app.chatView.messagesList.redrawMessage(e.ID)
+		})
+	}
+}
+
+// sendMessageData is the data of a sent message with enforce_nonce, which
+// api.SendMessageData lacks. Discord then creates at most one message with the
+// nonce, so a message whose response was lost is not created again when it is
+// retried.
+type sendMessageData struct {
+	api.SendMessageData
+	EnforceNonce bool `json:"enforce_nonce"`
+}
+
+func (d sendMessageData) WriteMultipart(body *multipart.Writer) error {
+	return sendpart.Write(body, d, d.Files)
+}
+
+func (o *outbox) send(e outboxEntry) (*discord.Message, error) {
+	data := sendMessageData{SendMessageData: e.Data, EnforceNonce: true}
+	for _, f := range e.Files {
+		file, err := os.Open(f.Path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+
+		data.Files = append(data.Files, sendpart.File{Name: f.Name, Reader: file})
+	}
+
+	if data.Content == "" && len(data.Files) == 0 {
+		return nil, api.ErrEmptyMessage
+	}
+
+	var message *discord.Message
+	url := api.EndpointChannels + e.ChannelID.String() + "/messages"
+	if err := sendpart.POST(discordState.Client.Client, data, &message, url); err != nil {
+		return nil, err
+	}
+
+	// Messages returned by the API do not have the guild ID set.
+	message.GuildID = e.GuildID
+	return message, nil
+}
+
+// isRetryableSendError reports whether sending the message again may succeed,
+// that is, whether the request did not reach Discord, was rate limited or
+// failed on Discord's side. Requests whose response was lost are retried too,
+// as the nonce is enforced. Files that cannot be read are not retried.
+func isRetryableSendError(err error) bool {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return false
+	}
+
+	var httpErr *httputil.HTTPError
+	if !errors.As(err, &httpErr) {
+		return true
+	}
+
+	return httpErr.Status == http.StatusTooManyRequests || httpErr.Status >= http.StatusInternalServerError
+}
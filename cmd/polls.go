@@ -0,0 +1,372 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	stdhttp "net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/gateway"
+	"github.com/diamondburned/arikawa/v3/utils/httputil"
+	"github.com/diamondburned/arikawa/v3/utils/ws"
+)
+
+// Limits of the polls that can be created.
+const (
+	maxPollQuestionLength = 300
+	maxPollAnswerLength   = 55
+	maxPollAnswers        = 10
+	maxPollDurationHours  = 32 * 24
+)
+
+// poll is the poll of a message. arikawa does not decode polls, so they are
+// read from the responses of the message endpoints and kept up to date with
+// the vote events.
+type poll struct {
+	Question         pollMedia         `json:"question"`
+	Answers          []pollAnswer      `json:"answers"`
+	Expiry           discord.Timestamp `json:"expiry"`
+	AllowMultiselect bool              `json:"allow_multiselect"`
+	Results          *pollResults      `json:"results,omitempty"`
+}
+
+type pollMedia struct {
+	Text  string                  `json:"text,omitempty"`
+	Emoji *discord.ComponentEmoji `json:"emoji,omitempty"`
+}
+
+type pollAnswer struct {
+	AnswerID  int       `json:"answer_id"`
+	PollMedia pollMedia `json:"poll_media"`
+}
+
+type pollResults struct {
+	IsFinalized  bool              `json:"is_finalized"`
+	AnswerCounts []pollAnswerCount `json:"answer_counts"`
+}
+
+type pollAnswerCount struct {
+	ID      int  `json:"id"`
+	Count   int  `json:"count"`
+	MeVoted bool `json:"me_voted"`
+}
+
+// count returns the number of votes for the answer and whether the current
+// user voted for it.
+func (p poll) count(answerID int) (int, bool) {
+	if p.Results == nil {
+		return 0, false
+	}
+
+	for _, c := range p.Results.AnswerCounts {
+		if c.ID == answerID {
+			return c.Count, c.MeVoted
+		}
+	}
+
+	return 0, false
+}
+
+func (p poll) totalVotes() int {
+	var total int
+	if p.Results != nil {
+		for _, c := range p.Results.AnswerCounts {
+			total += c.Count
+		}
+	}
+
+	return total
+}
+
+// closed reports whether the poll no longer accepts votes.
+func (p poll) closed() bool {
+	if p.Results != nil && p.Results.IsFinalized {
+		return true
+	}
+
+	return p.Expiry.IsValid() && p.Expiry.Time().Before(discord.NowTimestamp().Time())
+}
+
+// createPollData is the poll of a message that is sent.
+type createPollData struct {
+	Question         pollMedia          `json:"question"`
+	Answers          []createPollAnswer `json:"answers"`
+	Duration         int                `json:"duration"` // in hours
+	AllowMultiselect bool               `json:"allow_multiselect"`
+}
+
+type createPollAnswer struct {
+	PollMedia pollMedia `json:"poll_media"`
+}
+
+// newCreatePollData validates the fields of the poll composer. The answers are
+// given one per line and the duration in hours.
+func newCreatePollData(question, answers, duration string, allowMultiselect bool) (createPollData, error) {
+	data := createPollData{
+		Question:         pollMedia{Text: strings.TrimSpace(question)},
+		AllowMultiselect: allowMultiselect,
+	}
+
+	if data.Question.Text == "" {
+		return data, errors.New("the question is empty")
+	}
+	if utf8.RuneCountInString(data.Question.Text) > maxPollQuestionLength {
+		return data, fmt.Errorf("the question is longer than %d characters", maxPollQuestionLength)
+	}
+
+	for line := range strings.Lines(answers) {
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(answer) > maxPollAnswerLength {
+			return data, fmt.Errorf("an answer is longer than %d characters", maxPollAnswerLength)
+		}
+
+		data.Answers = append(data.Answers, createPollAnswer{PollMedia: pollMedia{Text: answer}})
+	}
+
+	if len(data.Answers) == 0 || len(data.Answers) > maxPollAnswers {
+		return data, fmt.Errorf("there must be 1 to %d answers", maxPollAnswers)
+	}
+
+	hours, err := strconv.Atoi(strings.TrimSpace(duration))
+	if err != nil || hours < 1 || hours > maxPollDurationHours {
+		return data, fmt.Errorf("the duration must be 1 to %d hours", maxPollDurationHours)
+	}
+	data.Duration = hours
+
+	return data, nil
+}
+
+// sendPoll sends a message with the poll to the channel. Messages with a poll
+// are sent like SendMessageComplex does, which cannot send them because
+// api.SendMessageData has no poll and requires content.
+func sendPoll(channelID discord.ChannelID, data createPollData) (*discord.Message, error) {
+	body := struct {
+		api.SendMessageData
+		Poll createPollData `json:"poll"`
+	}{Poll: data}
+
+	var message *discord.Message
+	err := discordState.RequestJSON(
+		&message, "POST",
+		api.EndpointChannels+channelID.String()+"/messages",
+		httputil.WithJSONBody(body),
+	)
+	return message, err
+}
+
+// votePoll replaces the votes of the current user in the poll with the
+// answers. No answers remove the votes.
+func votePoll(channelID discord.ChannelID, messageID discord.MessageID, answerIDs []int) error {
+	var body struct {
+		AnswerIDs []string `json:"answer_ids"`
+	}
+	body.AnswerIDs = make([]string, 0, len(answerIDs))
+	for _, id := range answerIDs {
+		body.AnswerIDs = append(body.AnswerIDs, strconv.Itoa(id))
+	}
+
+	return discordState.FastRequest(
+		"PUT",
+		api.EndpointChannels+channelID.String()+"/polls/"+messageID.String()+"/answers/@me",
+		httputil.WithJSONBody(body),
+	)
+}
+
+// messagePathPattern matches the paths of the endpoints that return messages.
+var messagePathPattern = regexp.MustCompile(`/channels/\d+/messages(/\d+)?$`)
+
+// pollStore holds the polls of the messages by their IDs.
+type pollStore struct {
+	mu    sync.Mutex
+	polls map[discord.MessageID]*poll
+}
+
+func newPollStore() *pollStore {
+	return &pollStore{polls: make(map[discord.MessageID]*poll)}
+}
+
+// poll returns a copy of the poll of the message.
+func (ps *pollStore) poll(messageID discord.MessageID) (poll, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	p, ok := ps.polls[messageID]
+	if !ok {
+		return poll{}, false
+	}
+
+	c := *p
+	c.Answers = slices.Clone(p.Answers)
+	if p.Results != nil {
+		results := *p.Results
+		results.AnswerCounts = slices.Clone(p.Results.AnswerCounts)
+		c.Results = &results
+	}
+
+	return c, true
+}
+
+func (ps *pollStore) has(messageID discord.MessageID) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	_, ok := ps.polls[messageID]
+	return ok
+}
+
+// addVote adds delta votes to the answer of the poll.
+func (ps *pollStore) addVote(messageID discord.MessageID, answerID int, me bool, delta int) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	p, ok := ps.polls[messageID]
+	if !ok {
+		return false
+	}
+
+	if p.Results == nil {
+		p.Results = &pollResults{}
+	}
+
+	i := slices.IndexFunc(p.Results.AnswerCounts, func(c pollAnswerCount) bool {
+		return c.ID == answerID
+	})
+	if i == -1 {
+		p.Results.AnswerCounts = append(p.Results.AnswerCounts, pollAnswerCount{ID: answerID})
+		i = len(p.Results.AnswerCounts) - 1
+	}
+
+	c := &p.Results.AnswerCounts[i]
+	c.Count = max(c.Count+delta, 0)
+	if me {
+		c.MeVoted = delta > 0
+	}
+
+	return true
+}
+
+// onBody records the polls of the messages returned by the message endpoints,
+// whose paths match messagePathPattern.
+func (ps *pollStore) onBody(req *stdhttp.Request, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+
+	type pollMessage struct {
+		ID   discord.MessageID `json:"id"`
+		Poll *poll             `json:"poll"`
+	}
+
+	var messages []pollMessage
+	var err error
+	if body[0] == '[' {
+		err = json.Unmarshal(body, &messages)
+	} else {
+		var message pollMessage
+		err = json.Unmarshal(body, &message)
+		messages = append(messages, message)
+	}
+
+	if err != nil {
+		slog.Error("failed to decode the polls of messages", "err", err, "path", req.URL.Path)
+		return
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	for _, m := range messages {
+		if m.Poll != nil {
+			ps.polls[m.ID] = m.Poll
+		}
+	}
+}
+
+// mayHavePoll reports whether the message can be a poll, which is the case for
+// messages that have nothing else to show.
+func mayHavePoll(message discord.Message) bool {
+	return message.Type == discord.DefaultMessage &&
+		message.Content == "" &&
+		len(message.Attachments) == 0 &&
+		len(message.Embeds) == 0 &&
+		len(message.Stickers) == 0 &&
+		len(message.Components) == 0 &&
+		len(message.MessageSnapshots) == 0
+}
+
+// fetchPoll fetches the message from the API so that its poll is recorded,
+// then renders it again.
+func fetchPoll(channelID discord.ChannelID, messageID discord.MessageID) {
+	if _, err := discordState.Client.Message(channelID, messageID); err != nil {
+		slog.Error("failed to fetch poll", "err", err, "channel_id", channelID, "message_id", messageID)
+		return
+	}
+
+	if app.polls.has(messageID) {
+		updateLoadedMessage(channelID, messageID)
+	}
+}
+
+// pollVoteEvent is the data of the poll vote events, which are not known to
+// arikawa.
+type pollVoteEvent struct {
+	UserID    discord.UserID    `json:"user_id"`
+	ChannelID discord.ChannelID `json:"channel_id"`
+	MessageID discord.MessageID `json:"message_id"`
+	GuildID   discord.GuildID   `json:"guild_id,omitempty"`
+	AnswerID  int               `json:"answer_id"`
+}
+
+type (
+	pollVoteAddEvent    struct{ pollVoteEvent }
+	pollVoteRemoveEvent struct{ pollVoteEvent }
+)
+
+// The poll vote events are dispatch events, whose op code is 0.
+func (*pollVoteAddEvent) Op() ws.OpCode              { return 0 }
+func (*pollVoteAddEvent) EventType() ws.EventType    { return "MESSAGE_POLL_VOTE_ADD" }
+func (*pollVoteRemoveEvent) Op() ws.OpCode           { return 0 }
+func (*pollVoteRemoveEvent) EventType() ws.EventType { return "MESSAGE_POLL_VOTE_REMOVE" }
+
+func init() {
+	gateway.OpUnmarshalers.Add(
+		func() ws.Event { return new(pollVoteAddEvent) },
+		func() ws.Event { return new(pollVoteRemoveEvent) },
+	)
+}
+
+func onPollVoteAdd(event *pollVoteAddEvent) {
+	onPollVote(event.pollVoteEvent, 1)
+}
+
+func onPollVoteRemove(event *pollVoteRemoveEvent) {
+	onPollVote(event.pollVoteEvent, -1)
+}
+
+func onPollVote(event pollVoteEvent, delta int) {
+	me, _ := discordState.Cabinet.Me()
+	isMe := me != nil && me.ID == event.UserID
+	if !app.polls.addVote(event.MessageID, event.AnswerID, isMe, delta) {
+		return
+	}
+
+	if app.chatView.selectedChannel != nil &&
+		app.chatView.selectedChannel.ID == event.ChannelID {
+		app.QueueUpdateDraw(func() {
+			app.chatView.messagesList.redrawMessage(event.MessageID)
+		})
+	}
+}
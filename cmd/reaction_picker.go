@@ -0,0 +1,280 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/discordo/internal/emoji"
+	"github.com/ayn2op/discordo/internal/ui"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/gdamore/tcell/v3"
+)
+
+// The bonuses that are added to the scores of the matches of the query, which
+// reorder the matches of the same kind but do not put a worse match first.
+const (
+	reactionBonus       = 50
+	maxRecentEmojiBonus = 49
+)
+
+// unicodeEmoji maps the keys of the Unicode emoji to their names, which
+// include the CLDR short name and the shortcodes.
+var unicodeEmoji = sync.OnceValue(func() map[string][]string {
+	names := make(map[string][]string, len(emoji.All()))
+	for _, e := range emoji.All() {
+		key := emojiKey(discord.Emoji{Name: e.Emoji})
+		if _, ok := names[key]; !ok {
+			names[key] = []string{e.Name}
+		}
+	}
+
+	for shortcode, e := range emojiShortcodes {
+		key := emojiKey(discord.Emoji{Name: e})
+		names[key] = append(names[key], shortcode)
+	}
+
+	for _, n := range names {
+		slices.Sort(n[1:])
+	}
+
+	return names
+})
+
+// emojiKey returns a key that is equal for the same emoji. Unicode emoji are
+// compared without variation selectors, which Discord does not always keep.
+func emojiKey(e discord.Emoji) string {
+	if e.IsUnicode() {
+		return strings.ReplaceAll(e.Name, "\ufe0f", "")
+	}
+	return e.ID.String()
+}
+
+// reactionPickerItem is an emoji that can be reacted with.
+type reactionPickerItem struct {
+	emoji discord.Emoji
+	// names are matched against the query.
+	names []string
+	// detail is shown after the name, e.g. the guild of a custom emoji.
+	detail string
+	// reaction is the reaction of the message with the emoji, if any.
+	reaction *discord.Reaction
+	recent   float64
+}
+
+func (item reactionPickerItem) text() string {
+	name := item.emoji.Name
+	if !item.emoji.IsUnicode() {
+		name = ":" + name + ":"
+	}
+
+	text := tview.Escape(name)
+	if len(item.names) > 0 && !strings.EqualFold(item.names[0], item.emoji.Name) {
+		text += "  " + tview.Escape(item.names[0])
+	}
+	if item.detail != "" {
+		text += "  [::d]" + tview.Escape(item.detail) + "[::D]"
+	}
+	if r := item.reaction; r != nil {
+		text += fmt.Sprintf("  (%d", r.Count)
+		if r.Me {
+			text += ", you"
+		}
+		text += ")"
+	}
+
+	return text
+}
+
+// reactionPicker lists the emoji that can be reacted to a message with,
+// filtered by a fuzzy query. The reactions of the message come first,
+// followed by the recently used emoji, the custom emoji and the Unicode emoji.
+type reactionPicker struct {
+	*tview.Flex
+	cfg *config.Config
+
+	input *tview.InputField
+	list  *tview.List
+
+	items []reactionPickerItem
+	shown []reactionPickerItem
+
+	onSelected func(emoji discord.Emoji)
+	onDone     func()
+}
+
+func newReactionPicker(cfg *config.Config, message discord.Message) *reactionPicker {
+	rp := &reactionPicker{
+		Flex: tview.NewFlex(),
+		cfg:  cfg,
+
+		input: tview.NewInputField(),
+		list:  tview.NewList(),
+	}
+
+	rp.input.
+		SetLabel("Search: ").
+		SetChangedFunc(rp.filter)
+	rp.input.SetInputCapture(rp.onInputCapture)
+
+	rp.list.
+		SetWrapAround(true).
+		SetHighlightFullLine(true).
+		ShowSecondaryText(false)
+	rp.list.SetSelectedFunc(func(index int, _, _ string, _ rune) {
+		rp.selectItem(index)
+	})
+
+	rp.Box = ui.ConfigureBox(rp.Box, &cfg.Theme)
+	rp.
+		SetDirection(tview.FlexRow).
+		AddItem(rp.input, 1, 0, true).
+		AddItem(rp.list, 0, 1, false).
+		SetTitle("Add Reaction")
+
+	rp.items = reactionPickerItems(message)
+	rp.filter("")
+	return rp
+}
+
+// reactionPickerItems returns the emoji that can be reacted to the message
+// with, without duplicates.
+func reactionPickerItems(message discord.Message) []reactionPickerItem {
+	var items []reactionPickerItem
+	seen := make(map[string]struct{})
+	add := func(item reactionPickerItem) {
+		key := emojiKey(item.emoji)
+		if _, ok := seen[key]; ok {
+			return
+		}
+
+		if item.emoji.IsUnicode() {
+			item.names = unicodeEmoji()[key]
+		}
+		if len(item.names) == 0 {
+			item.names = []string{item.emoji.Name}
+		}
+
+		item.recent = app.recentEmoji.score(item.emoji)
+		seen[key] = struct{}{}
+		items = append(items, item)
+	}
+
+	for i := range message.Reactions {
+		r := &message.Reactions[i]
+		add(reactionPickerItem{emoji: r.Emoji, reaction: r})
+	}
+
+	for _, e := range app.recentEmoji.sorted() {
+		add(reactionPickerItem{emoji: e.emoji()})
+	}
+
+	guildID := message.GuildID
+	if !guildID.IsValid() {
+		if channel, err := discordState.Cabinet.Channel(message.ChannelID); err == nil {
+			guildID = channel.GuildID
+		}
+	}
+
+	// The custom emoji of the guild, or of all guilds with Nitro.
+	guilds, err := discordState.EmojiState.ForGuild(guildID)
+	if err != nil {
+		slog.Error("failed to get custom emoji", "err", err, "guild_id", guildID)
+	}
+	for _, g := range guilds {
+		for _, e := range g.Emojis {
+			add(reactionPickerItem{emoji: e, detail: g.Name})
+		}
+	}
+
+	for _, e := range emoji.All() {
+		add(reactionPickerItem{emoji: discord.Emoji{Name: e.Emoji}})
+	}
+
+	return items
+}
+
+// filter lists the items that match the query, best matches first. All items
+// are listed if the query is empty.
+func (rp *reactionPicker) filter(query string) {
+	rp.list.Clear()
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		rp.shown = rp.items
+	} else {
+		type match struct {
+			item  reactionPickerItem
+			score int
+		}
+
+		var matches []match
+		for _, item := range rp.items {
+			var score int
+			for _, name := range item.names {
+				score = max(score, fuzzyMatchScore(query, name))
+			}
+			if score == 0 {
+				continue
+			}
+
+			if item.reaction != nil {
+				score += reactionBonus
+			}
+			score += min(int(item.recent*10), maxRecentEmojiBonus)
+			matches = append(matches, match{item, score})
+		}
+
+		slices.SortStableFunc(matches, func(a, b match) int {
+			return cmp.Compare(b.score, a.score)
+		})
+
+		rp.shown = make([]reactionPickerItem, len(matches))
+		for i, m := range matches {
+			rp.shown[i] = m.item
+		}
+	}
+
+	for _, item := range rp.shown {
+		rp.list.AddItem(item.text(), "", 0, nil)
+	}
+}
+
+func (rp *reactionPicker) selectItem(index int) {
+	if index >= 0 && index < len(rp.shown) && rp.onSelected != nil {
+		rp.onSelected(rp.shown[index].emoji)
+	}
+}
+
+// onInputCapture moves the selection of the list while the query is typed.
+func (rp *reactionPicker) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	var key tcell.Key
+	switch event.Name() {
+	case "Up", rp.cfg.Keys.MentionsList.Up:
+		key = tcell.KeyUp
+	case "Down", rp.cfg.Keys.MentionsList.Down:
+		key = tcell.KeyDown
+	case "PgUp":
+		key = tcell.KeyPgUp
+	case "PgDn":
+		key = tcell.KeyPgDn
+	case "Enter":
+		rp.selectItem(rp.list.GetCurrentItem())
+		return nil
+	case "Esc":
+		if rp.onDone != nil {
+			rp.onDone()
+		}
+		return nil
+	default:
+		return event
+	}
+
+	rp.list.InputHandler()(tcell.NewEventKey(key, "", tcell.ModNone), func(tview.Primitive) {})
+	return nil
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/discordo/internal/ui"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/gdamore/tcell/v3"
+)
+
+// reactorsPageSize is the number of users that are fetched per request, the
+// most that Discord returns.
+const reactorsPageSize = api.MaxMessageReactionFetchLimit
+
+// reactorsList shows the reactions of a message and the users behind the
+// selected one. The users are fetched a page at a time as the list is
+// scrolled to its end.
+type reactorsList struct {
+	*tview.Flex
+	cfg     *config.Config
+	message discord.Message
+
+	reactions *tview.List
+	users     *tview.List
+
+	// emoji is the reaction whose users are listed.
+	emoji   discord.APIEmoji
+	loaded  []discord.User
+	loading bool
+	more    bool
+
+	onDone func()
+}
+
+func newReactorsList(cfg *config.Config, message discord.Message) *reactorsList {
+	rl := &reactorsList{
+		Flex:    tview.NewFlex(),
+		cfg:     cfg,
+		message: message,
+
+		reactions: tview.NewList(),
+		users:     tview.NewList(),
+	}
+
+	for _, list := range []*tview.List{rl.reactions, rl.users} {
+		list.
+			SetWrapAround(false).
+			SetHighlightFullLine(true).
+			ShowSecondaryText(false)
+		list.SetInputCapture(rl.onInputCapture)
+	}
+
+	for _, r := range message.Reactions {
+		name := r.Emoji.Name
+		if !r.Emoji.IsUnicode() {
+			name = ":" + name + ":"
+		}
+		rl.reactions.AddItem(fmt.Sprintf("%s %d", tview.Escape(name), r.Count), "", 0, nil)
+	}
+
+	rl.reactions.SetChangedFunc(func(index int, _, _ string, _ rune) {
+		rl.setReaction(index)
+	})
+	rl.reactions.SetSelectedFunc(func(int, string, string, rune) {
+		app.SetFocus(rl.users)
+	})
+	rl.users.SetChangedFunc(func(index int, _, _ string, _ rune) {
+		// The last item is shown while more users can be loaded.
+		if rl.more && index == len(rl.loaded) {
+			rl.loadMore()
+		}
+	})
+
+	rl.Box = ui.ConfigureBox(rl.Box, &cfg.Theme)
+	rl.
+		AddItem(rl.reactions, 20, 0, true).
+		AddItem(rl.users, 0, 1, false).
+		SetTitle("Reactions")
+
+	rl.setReaction(0)
+	return rl
+}
+
+// setReaction lists the users of the reaction at the index.
+func (rl *reactorsList) setReaction(index int) {
+	if index < 0 || index >= len(rl.message.Reactions) {
+		return
+	}
+
+	emoji := rl.message.Reactions[index].Emoji.APIString()
+	if emoji == rl.emoji && rl.users.GetItemCount() > 0 {
+		return
+	}
+
+	rl.emoji = emoji
+	rl.loaded = nil
+	rl.loading = false
+	rl.more = true
+	rl.users.Clear()
+	rl.loadMore()
+}
+
+// loadMore fetches the next page of users of the reaction in the background.
+func (rl *reactorsList) loadMore() {
+	if rl.loading || !rl.more {
+		return
+	}
+
+	rl.loading = true
+	rl.drawUsers()
+
+	var after discord.UserID
+	if len(rl.loaded) > 0 {
+		after = rl.loaded[len(rl.loaded)-1].ID
+	}
+
+	emoji := rl.emoji
+	go func() {
+		users, err := discordState.ReactionsAfter(rl.message.ChannelID, rl.message.ID, after, emoji, reactorsPageSize)
+		if err != nil {
+			slog.Error("failed to get reactions", "err", err, "channel_id", rl.message.ChannelID, "message_id", rl.message.ID, "emoji", emoji)
+		}
+
+		app.QueueUpdateDraw(func() {
+			// Another reaction was selected in the meantime.
+			if emoji != rl.emoji {
+				return
+			}
+
+			rl.loading = false
+			rl.more = err == nil && len(users) == reactorsPageSize
+			rl.loaded = append(rl.loaded, users...)
+			rl.drawUsers()
+		})
+	}()
+}
+
+func (rl *reactorsList) drawUsers() {
+	current := rl.users.GetCurrentItem()
+	// Adding the first item calls the changed func, which would load more.
+	more := rl.more
+	rl.more = false
+	rl.users.Clear()
+
+	for _, user := range rl.loaded {
+		rl.users.AddItem(rl.userText(user), "", 0, nil)
+	}
+
+	switch {
+	case rl.loading:
+		rl.users.AddItem("[::d]Loading...[::D]", "", 0, nil)
+	case more:
+		rl.users.AddItem("[::d]More[::D]", "", 0, nil)
+	case len(rl.loaded) == 0:
+		rl.users.AddItem("[::d]No users[::D]", "", 0, nil)
+	}
+
+	rl.users.SetCurrentItem(current)
+	rl.more = more
+}
+
+func (rl *reactorsList) userText(user discord.User) string {
+	name := user.DisplayOrUsername()
+	if rl.message.GuildID.IsValid() {
+		if member, err := discordState.Cabinet.Member(rl.message.GuildID, user.ID); err == nil && member.Nick != "" {
+			name = member.Nick
+		}
+	}
+
+	if name == user.Username {
+		return tview.Escape(name)
+	}
+
+	return fmt.Sprintf("%s [::d]%s[::D]", tview.Escape(name), tview.Escape(user.Username))
+}
+
+func (rl *reactorsList) onInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Name() {
+	case rl.cfg.Keys.MessagesList.SelectPrevious:
+		return tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone)
+	case rl.cfg.Keys.MessagesList.SelectNext:
+		return tcell.NewEventKey(tcell.KeyDown, "", tcell.ModNone)
+	case rl.cfg.Keys.MessagesList.SelectFirst:
+		return tcell.NewEventKey(tcell.KeyHome, "", tcell.ModNone)
+	case rl.cfg.Keys.MessagesList.SelectLast:
+		return tcell.NewEventKey(tcell.KeyEnd, "", tcell.ModNone)
+	case "Tab", "Left", "Right":
+		if app.GetFocus() == rl.users {
+			app.SetFocus(rl.reactions)
+		} else {
+			app.SetFocus(rl.users)
+		}
+		return nil
+	case "Esc", rl.cfg.Keys.MessagesList.Cancel:
+		if rl.onDone != nil {
+			rl.onDone()
+		}
+		return nil
+	}
+
+	return event
+}
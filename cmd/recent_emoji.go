@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+const (
+	// maxRecentEmoji is the number of emoji that are remembered.
+	maxRecentEmoji = 50
+	// recentEmojiHalfLife is the time after which a use of an emoji counts
+	// for half as much.
+	recentEmojiHalfLife = 7 * 24 * time.Hour
+)
+
+// recentEmojiEntry is an emoji that was reacted with. Custom emoji keep their
+// ID and name so that they can be shown without the guild they are from.
+type recentEmojiEntry struct {
+	ID       discord.EmojiID `json:"id,omitempty"`
+	Name     string          `json:"name"`
+	Animated bool            `json:"animated,omitempty"`
+	Uses     int             `json:"uses"`
+	LastUsed time.Time       `json:"last_used"`
+}
+
+func (e recentEmojiEntry) emoji() discord.Emoji {
+	return discord.Emoji{ID: e.ID, Name: e.Name, Animated: e.Animated}
+}
+
+// score is the frecency of the emoji: its uses, decayed by the time since it
+// was last used.
+func (e recentEmojiEntry) score(now time.Time) float64 {
+	age := now.Sub(e.LastUsed)
+	return float64(e.Uses) * math.Exp2(-float64(age)/float64(recentEmojiHalfLife))
+}
+
+// recentEmoji remembers the emoji that were reacted with, ordered by
+// frecency, and persists them across restarts. It is only used from the UI
+// goroutine.
+type recentEmoji struct {
+	path    string
+	entries map[discord.APIEmoji]*recentEmojiEntry
+}
+
+func newRecentEmoji(path string) *recentEmoji {
+	re := &recentEmoji{
+		path:    path,
+		entries: make(map[discord.APIEmoji]*recentEmojiEntry),
+	}
+
+	re.load()
+	return re
+}
+
+func (re *recentEmoji) load() {
+	data, err := os.ReadFile(re.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			slog.Warn("failed to load recent emoji", "err", err)
+		}
+		return
+	}
+
+	var entries []*recentEmojiEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		slog.Error("failed to parse recent emoji", "err", err)
+		return
+	}
+
+	for _, e := range entries {
+		re.entries[e.emoji().APIString()] = e
+	}
+}
+
+func (re *recentEmoji) save() {
+	data, err := json.Marshal(re.sorted())
+	if err != nil {
+		slog.Error("failed to marshal recent emoji", "err", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(re.path), 0755); err != nil {
+		slog.Error("failed to create cache directory", "err", err)
+		return
+	}
+
+	tmp := re.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		slog.Error("failed to write recent emoji", "err", err)
+		return
+	}
+
+	if err := os.Rename(tmp, re.path); err != nil {
+		slog.Error("failed to write recent emoji", "err", err)
+	}
+}
+
+// use records a use of the emoji, forgetting the emoji with the lowest
+// frecency if there are too many.
+func (re *recentEmoji) use(emoji discord.Emoji) {
+	key := emoji.APIString()
+	e, ok := re.entries[key]
+	if !ok {
+		e = &recentEmojiEntry{ID: emoji.ID, Name: emoji.Name, Animated: emoji.Animated}
+		re.entries[key] = e
+	}
+
+	e.Uses++
+	e.LastUsed = time.Now()
+
+	if sorted := re.sorted(); len(sorted) > maxRecentEmoji {
+		for _, e := range sorted[maxRecentEmoji:] {
+			delete(re.entries, e.emoji().APIString())
+		}
+	}
+
+	re.save()
+}
+
+// score returns the frecency of the emoji, or zero if it was never used.
+func (re *recentEmoji) score(emoji discord.Emoji) float64 {
+	if e, ok := re.entries[emoji.APIString()]; ok {
+		return e.score(time.Now())
+	}
+	return 0
+}
+
+// sorted returns the entries with the highest frecency first.
+func (re *recentEmoji) sorted() []*recentEmojiEntry {
+	now := time.Now()
+	entries := make([]*recentEmojiEntry, 0, len(re.entries))
+	for _, e := range re.entries {
+		entries = append(entries, e)
+	}
+
+	slices.SortFunc(entries, func(a, b *recentEmojiEntry) int {
+		if c := cmp.Compare(b.score(now), a.score(now)); c != 0 {
+			return c
+		}
+		return b.LastUsed.Compare(a.LastUsed)
+	})
+	return entries
+}
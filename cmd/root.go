@@ -8,6 +8,7 @@ import (
 
 	"github.com/ayn2op/discordo/internal/config"
 	"github.com/ayn2op/discordo/internal/consts"
+	"github.com/ayn2op/discordo/internal/diskstore"
 	"github.com/ayn2op/discordo/internal/http"
 	"github.com/ayn2op/discordo/internal/keyring"
 	"github.com/ayn2op/discordo/internal/logger"
@@ -19,6 +20,8 @@ import (
 
 var (
 	discordState *ningen.State
+	// discordStore persists the state's cabinet; nil if the cache is disabled.
+	discordStore *diskstore.Store
 	app          *application
 )
 
@@ -26,6 +29,7 @@ var (
 	token      string
 	email      string
 	password   string
+	offline    bool
 	configPath string
 	logPath    string
 	logLevel   string
@@ -33,21 +37,8 @@ var (
 	rootCmd = &cobra.Command{
 		Use: consts.Name,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var level slog.Level
-			switch logLevel {
-			case "debug":
-				ws.EnableRawEvents = true
-				level = slog.LevelDebug
-			case "info":
-				level = slog.LevelInfo
-			case "warn":
-				level = slog.LevelWarn
-			case "error":
-				level = slog.LevelError
-			}
-
-			if err := logger.Load(logPath, level); err != nil {
-				return fmt.Errorf("failed to load logger: %w", err)
+			if err := loadLogger(); err != nil {
+				return err
 			}
 
 			cfg, err := config.Load(configPath)
@@ -64,25 +55,53 @@ var (
 				slog.Info("successfully logged in with email/password")
 			}
 
-			if token == "" {
-				token = os.Getenv("DISCORDO_TOKEN")
-			}
-
-			if token == "" {
-				token, err = keyring.GetToken()
-				if err != nil {
-					slog.Info("failed to retrieve token from keyring", "err", err)
-				}
-			}
-
 			app = newApplication(cfg)
-			return app.run(token)
+			return app.run(resolveToken())
 		},
 	}
 
 	Execute = rootCmd.Execute
 )
 
+func loadLogger() error {
+	var level slog.Level
+	switch logLevel {
+	case "debug":
+		ws.EnableRawEvents = true
+		level = slog.LevelDebug
+	case "info":
+		level = slog.LevelInfo
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	}
+
+	if err := logger.Load(logPath, level); err != nil {
+		return fmt.Errorf("failed to load logger: %w", err)
+	}
+
+	return nil
+}
+
+// resolveToken returns the token from the flag, the environment or the
+// keyring, in that order. It is empty if none has one.
+func resolveToken() string {
+	if token != "" {
+		return token
+	}
+
+	if token := os.Getenv("DISCORDO_TOKEN"); token != "" {
+		return token
+	}
+
+	token, err := keyring.GetToken()
+	if err != nil {
+		slog.Info("failed to retrieve token from keyring", "err", err)
+	}
+	return token
+}
+
 func loginWithCredentials(email, password string) (string, error) {
 	// Create an API client without an authentication token
 	client := api.NewClient("")
@@ -113,13 +132,15 @@ func loginWithCredentials(email, password string) (string, error) {
 }
 
 func init() {
+	// The flags that the subcommands share.
+	persistentFlags := rootCmd.PersistentFlags()
+	persistentFlags.StringVar(&token, "token", "", "authentication token (default: $DISCORDO_TOKEN or keyring)")
+	persistentFlags.StringVar(&configPath, "config-path", config.DefaultPath(), "path of the configuration file")
+	persistentFlags.StringVar(&logPath, "log-path", logger.DefaultPath(), "path of the log file")
+	persistentFlags.StringVar(&logLevel, "log-level", "info", "log level")
+
 	flags := rootCmd.Flags()
-	flags.StringVar(&token, "token", "", "authentication token (default: $DISCORDO_TOKEN or keyring)")
 	flags.StringVar(&email, "email", "", "login with email address")
 	flags.StringVar(&password, "password", "", "login with password")
-
-	flags.StringVar(&configPath, "config-path", config.DefaultPath(), "path of the configuration file")
-
-	flags.StringVar(&logPath, "log-path", logger.DefaultPath(), "path of the log file")
-	flags.StringVar(&logLevel, "log-level", "info", "log level")
+	flags.BoolVar(&offline, "offline", false, "start from the cached state and connect in the background")
 }
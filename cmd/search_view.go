@@ -0,0 +1,352 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/discordo/internal/ui"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/gdamore/tcell/v3"
+)
+
+// searchPageSize is the number of results returned by Discord per search
+// request.
+const searchPageSize = 25
+
+// searchHasValues are the values accepted by the has: filter.
+var searchHasValues = []string{"link", "embed", "file", "image", "video", "sound", "sticker", "poll"}
+
+type searchView struct {
+	*tview.Flex
+	cfg *config.Config
+
+	input *tview.InputField
+	list  *tview.List
+
+	// guildID is the guild that is searched, or zero to search the DM
+	// channel with channelID.
+	guildID   discord.GuildID
+	channelID discord.ChannelID
+
+	data    api.SearchData
+	total   uint
+	results []discord.Message
+
+	onSelected func(message discord.Message)
+	onDone     func()
+}
+
+func newSearchView(cfg *config.Config) *searchView {
+	sv := &searchView{
+		Flex: tview.NewFlex(),
+		cfg:  cfg,
+
+		input: tview.NewInputField(),
+		list:  tview.NewList(),
+	}
+
+	sv.input.
+		SetLabel("Search: ").
+		SetPlaceholder("from:user in:#channel has:link before:2006-01-02 after:2006-01-02").
+		SetDoneFunc(sv.onInputDone)
+
+	sv.list.
+		SetWrapAround(false).
+		SetHighlightFullLine(true).
+		ShowSecondaryText(true)
+	sv.list.SetInputCapture(sv.onListInputCapture)
+	sv.list.SetSelectedFunc(func(index int, _, _ string, _ rune) {
+		if index < len(sv.results) && sv.onSelected != nil {
+			sv.onSelected(sv.results[index])
+		}
+	})
+
+	sv.Box = ui.ConfigureBox(sv.Box, &cfg.Theme)
+	sv.
+		SetDirection(tview.FlexRow).
+		AddItem(sv.input, 1, 0, true).
+		AddItem(sv.list, 0, 1, false).
+		SetTitle("Search Messages")
+
+	return sv
+}
+
+// setScope sets the guild, or the DM channel if the channel is not in a guild,
+// that is searched.
+func (sv *searchView) setScope(channel discord.Channel) {
+	if sv.guildID == channel.GuildID && (channel.GuildID.IsValid() || sv.channelID == channel.ID) {
+		return
+	}
+
+	sv.guildID = channel.GuildID
+	sv.channelID = channel.ID
+	sv.total = 0
+	sv.results = nil
+	sv.input.SetText("")
+	sv.list.Clear()
+}
+
+func (sv *searchView) onInputDone(key tcell.Key) {
+	switch key {
+	case tcell.KeyEnter:
+		data, err := sv.parseQuery(sv.input.GetText())
+		if err != nil {
+			sv.list.Clear().AddItem(err.Error(), "", 0, nil)
+			return
+		}
+
+		sv.search(data)
+	case tcell.KeyEscape:
+		if sv.onDone != nil {
+			sv.onDone()
+		}
+	}
+}
+
+func (sv *searchView) onListInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Name() {
+	case sv.cfg.Keys.Search.SelectPrevious:
+		return tcell.NewEventKey(tcell.KeyUp, "", tcell.ModNone)
+	case sv.cfg.Keys.Search.SelectNext:
+		return tcell.NewEventKey(tcell.KeyDown, "", tcell.ModNone)
+	case sv.cfg.Keys.Search.SelectFirst:
+		return tcell.NewEventKey(tcell.KeyHome, "", tcell.ModNone)
+	case sv.cfg.Keys.Search.SelectLast:
+		return tcell.NewEventKey(tcell.KeyEnd, "", tcell.ModNone)
+	case sv.cfg.Keys.Search.NextPage:
+		if sv.data.Offset+searchPageSize < sv.total {
+			data := sv.data
+			data.Offset += searchPageSize
+			sv.search(data)
+		}
+		return nil
+	case sv.cfg.Keys.Search.PreviousPage:
+		if sv.data.Offset > 0 {
+			data := sv.data
+			data.Offset -= min(data.Offset, searchPageSize)
+			sv.search(data)
+		}
+		return nil
+	case sv.cfg.Keys.Search.EditQuery:
+		app.SetFocus(sv.input)
+		return nil
+	case sv.cfg.Keys.Search.Cancel:
+		if sv.onDone != nil {
+			sv.onDone()
+		}
+		return nil
+	}
+
+	return event
+}
+
+// parseQuery splits the query into its filters and the content to search for.
+func (sv *searchView) parseQuery(query string) (api.SearchData, error) {
+	data := api.SearchData{IncludeNSFW: true}
+	if !sv.guildID.IsValid() {
+		data.ChannelID = sv.channelID
+	}
+
+	var content []string
+	for _, field := range strings.Fields(query) {
+		key, value, ok := strings.Cut(field, ":")
+		if !ok || value == "" {
+			content = append(content, field)
+			continue
+		}
+
+		switch key {
+		case "from", "mentions":
+			userID, err := sv.resolveUser(value)
+			if err != nil {
+				return data, err
+			}
+
+			if key == "from" {
+				data.AuthorID = userID
+			} else {
+				data.Mentions = userID
+			}
+		case "in":
+			if !sv.guildID.IsValid() {
+				return data, fmt.Errorf("in: is only supported in servers")
+			}
+
+			channelID, err := sv.resolveChannel(value)
+			if err != nil {
+				return data, err
+			}
+
+			data.ChannelID = channelID
+		case "has":
+			if !slices.Contains(searchHasValues, value) {
+				return data, fmt.Errorf("has: must be one of %s", strings.Join(searchHasValues, ", "))
+			}
+
+			data.Has = value
+		case "before", "after":
+			t, err := time.ParseInLocation(time.DateOnly, value, time.Local)
+			if err != nil {
+				return data, fmt.Errorf("%s: must be a date formatted as YYYY-MM-DD", key)
+			}
+
+			if key == "before" {
+				data.MaxID = discord.MessageID(discord.NewSnowflake(t))
+			} else {
+				// after: excludes the given day.
+				data.MinID = discord.MessageID(discord.NewSnowflake(t.AddDate(0, 0, 1)))
+			}
+		default:
+			content = append(content, field)
+		}
+	}
+
+	data.Content = strings.Join(content, " ")
+	return data, nil
+}
+
+// resolveUser returns the ID of the user referred to by a mention, an ID or the
+// username or nickname of a member (or recipient in DMs).
+func (sv *searchView) resolveUser(value string) (discord.UserID, error) {
+	value = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(value, "<@"), "!"), ">")
+	if id, err := discord.ParseSnowflake(value); err == nil {
+		return discord.UserID(id), nil
+	}
+
+	value = strings.TrimPrefix(value, "@")
+	if sv.guildID.IsValid() {
+		members, err := discordState.Cabinet.Members(sv.guildID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get members: %w", err)
+		}
+
+		for _, m := range members {
+			if strings.EqualFold(m.User.Username, value) || strings.EqualFold(m.Nick, value) || strings.EqualFold(m.User.DisplayName, value) {
+				return m.User.ID, nil
+			}
+		}
+	} else {
+		channel, err := discordState.Cabinet.Channel(sv.channelID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get channel: %w", err)
+		}
+
+		me, _ := discordState.Cabinet.Me()
+		users := channel.DMRecipients
+		if me != nil {
+			users = append(users, *me)
+		}
+
+		for _, u := range users {
+			if strings.EqualFold(u.Username, value) || strings.EqualFold(u.DisplayName, value) {
+				return u.ID, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unknown user %q", value)
+}
+
+// resolveChannel returns the ID of the channel of the guild referred to by a
+// mention, an ID or its name.
+func (sv *searchView) resolveChannel(value string) (discord.ChannelID, error) {
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "<#"), ">")
+	if id, err := discord.ParseSnowflake(value); err == nil {
+		return discord.ChannelID(id), nil
+	}
+
+	value = strings.TrimPrefix(value, "#")
+	channels, err := discordState.Cabinet.Channels(sv.guildID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get channels: %w", err)
+	}
+
+	for _, c := range channels {
+		if c.Type != discord.GuildCategory && strings.EqualFold(c.Name, value) {
+			return c.ID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown channel %q", value)
+}
+
+func (sv *searchView) search(data api.SearchData) {
+	sv.data = data
+	sv.results = nil
+	sv.list.Clear().AddItem("Searching...", "", 0, nil)
+	app.SetFocus(sv.list)
+
+	guildID := sv.guildID
+	go func() {
+		var (
+			resp api.SearchResponse
+			err  error
+		)
+		if guildID.IsValid() {
+			resp, err = discordState.Search(guildID, data)
+		} else {
+			resp, err = discordState.SearchDirectMessages(data)
+		}
+
+		app.QueueUpdateDraw(func() {
+			// The scope or the query changed while searching.
+			if sv.guildID != guildID || sv.data != data {
+				return
+			}
+
+			if err != nil {
+				slog.Error("failed to search messages", "err", err, "guild_id", guildID, "channel_id", data.ChannelID)
+				sv.list.Clear().AddItem("Failed to search messages", err.Error(), 0, nil)
+				return
+			}
+
+			sv.setResults(resp)
+		})
+	}()
+}
+
+func (sv *searchView) setResults(resp api.SearchResponse) {
+	sv.total = resp.TotalResults
+	sv.results = sv.results[:0]
+	sv.list.Clear()
+
+	for _, messages := range resp.Messages {
+		// Each result is the matched message, formerly surrounded by context
+		// messages with the match in the middle.
+		if len(messages) == 0 {
+			continue
+		}
+
+		message := messages[len(messages)/2]
+		sv.results = append(sv.results, message)
+
+		content := strings.ReplaceAll(message.Content, "\n", " ")
+		if runes := []rune(content); len(runes) > 70 {
+			content = string(runes[:67]) + "..."
+		}
+		if content == "" {
+			content = "[attachment or embed]"
+		}
+
+		secondary := message.Timestamp.Time().In(time.Local).Format(sv.cfg.Timestamps.Format)
+		if channel, err := discordState.Cabinet.Channel(message.ChannelID); err == nil && sv.guildID.IsValid() {
+			secondary = ui.ChannelToString(*channel) + " - " + secondary
+		}
+
+		mainText := fmt.Sprintf("%s: %s", message.Author.DisplayOrUsername(), content)
+		sv.list.AddItem(tview.Escape(mainText), tview.Escape(secondary), 0, nil)
+	}
+
+	if len(sv.results) == 0 {
+		sv.list.AddItem("No results", "", 0, nil)
+	}
+
+	pages := (sv.total + searchPageSize - 1) / searchPageSize
+	sv.SetTitle(fmt.Sprintf("Search Messages - %d results (page %d/%d)", sv.total, sv.data.Offset/searchPageSize+1, max(pages, 1)))
+}
@@ -2,9 +2,17 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
-
+	stdhttp "net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ayn2op/discordo/internal/consts"
+	"github.com/ayn2op/discordo/internal/diskstore"
 	"github.com/ayn2op/discordo/internal/http"
 	"github.com/ayn2op/discordo/internal/notifications"
 	"github.com/ayn2op/tview"
@@ -12,6 +20,7 @@ import (
 	"github.com/diamondburned/arikawa/v3/gateway"
 	"github.com/diamondburned/arikawa/v3/session"
 	"github.com/diamondburned/arikawa/v3/state"
+	"github.com/diamondburned/arikawa/v3/state/store"
 	"github.com/diamondburned/arikawa/v3/state/store/defaultstore"
 	"github.com/diamondburned/arikawa/v3/utils/handler"
 	"github.com/diamondburned/arikawa/v3/utils/httputil"
@@ -21,7 +30,20 @@ import (
 	"github.com/diamondburned/ningen/v3/states/read"
 )
 
-func openState(token string) error {
+// maxStoredMessages is the number of messages kept per channel in the state,
+// which is the same as defaultstore's.
+const maxStoredMessages = 100
+
+// minReconnectDelay and maxReconnectDelay bound the delay between attempts to
+// connect to the gateway while offline.
+const (
+	minReconnectDelay = 2 * time.Second
+	maxReconnectDelay = 2 * time.Minute
+)
+
+// newState creates the state and registers the handlers without connecting
+// to the gateway.
+func newState(token string) {
 	identifyProps := http.IdentifyProperties()
 	gateway.DefaultIdentity = identifyProps
 	gateway.DefaultPresence = &gateway.UpdatePresenceCommand{
@@ -31,14 +53,27 @@ func openState(token string) error {
 	id := gateway.DefaultIdentifier(token)
 	id.Compress = false
 
-	session := session.NewCustom(id, http.NewClient(token), handler.New())
-	state := state.NewFromSession(session, defaultstore.New())
+	transport := http.NewTransport()
+	transport.OnBody(messagePathPattern, app.polls.onBody)
+
+	session := session.NewCustom(id, http.NewCustomClient(token, transport), handler.New())
+	state := state.NewFromSession(session, openStore())
 	discordState = ningen.FromState(state)
 
 	// Handlers
 	discordState.AddHandler(onRaw)
 	discordState.AddHandler(onReady)
+	discordState.AddHandler(onResumed)
+	discordState.AddHandler(onClose)
+	discordState.AddHandler(onHello)
+	discordState.AddHandler(onInvalidSession)
+	discordState.AddHandler(onHeartbeatAck)
+	discordState.AddHandler(onSessionsReplace)
+	discordState.AddHandler(onTypingStart)
 	discordState.AddHandler(onChannelCreate)
+	discordState.AddHandler(onThreadCreate)
+	discordState.AddHandler(onThreadUpdate)
+	discordState.AddHandler(onThreadDelete)
 	discordState.AddHandler(onMessageCreate)
 	discordState.AddHandler(onMessageUpdate)
 	discordState.AddHandler(onMessageDelete)
@@ -51,6 +86,14 @@ func openState(token string) error {
 	discordState.AddHandler(onMessageReactionAdd)
 	discordState.AddHandler(onMessageReactionRemove)
 	discordState.AddHandler(onMessageReactionRemoveAll)
+	discordState.AddHandler(onMessageReactionRemoveEmoji)
+	discordState.AddHandler(onGuildRoleUpdate)
+	discordState.AddHandler(onGuildRoleDelete)
+	discordState.AddHandler(onPollVoteAdd)
+	discordState.AddHandler(onPollVoteRemove)
+	discordState.AddHandler(onInteractionSuccess)
+	discordState.AddHandler(onInteractionFailure)
+	discordState.AddHandler(onInteractionModalCreate)
 
 	discordState.AddHandler(func(event *gateway.GuildMembersChunkEvent) {
 		app.chatView.messagesList.setFetchingChunk(false, uint(len(event.Members)))
@@ -65,7 +108,87 @@ func openState(token string) error {
 	}
 
 	discordState.OnRequest = append(discordState.OnRequest, httputil.WithHeaders(http.Headers()), onRequest)
-	return discordState.Open(context.TODO())
+	discordState.Client.Client.OnResponse = append(discordState.Client.Client.OnResponse, onResponse)
+}
+
+// openOffline shows the state persisted by a previous session and connects to
+// the gateway in the background. The UI switches to live mode on READY.
+func openOffline() error {
+	if discordStore == nil {
+		return errors.New("the cache must be enabled to start offline")
+	}
+
+	if _, err := discordState.Cabinet.Me(); err != nil {
+		return fmt.Errorf("no cached state to start offline from: %w", err)
+	}
+
+	folders := discordStore.GuildFolders()
+	if len(folders) == 0 {
+		guilds, _ := discordState.Cabinet.Guilds()
+		for _, guild := range guilds {
+			folders = append(folders, gateway.GuildFolder{GuildIDs: []discord.GuildID{guild.ID}})
+		}
+	}
+
+	app.chatView.setOffline(true)
+	app.chatView.guildsTree.setGuildFolders(folders)
+	app.SetFocus(app.chatView.guildsTree)
+
+	go reconnect()
+	return nil
+}
+
+// reconnect connects to the gateway, retrying with an exponential backoff until
+// it succeeds.
+func reconnect() {
+	delay := minReconnectDelay
+	for {
+		err := discordState.Open(context.TODO())
+		if err == nil {
+			return
+		}
+
+		slog.Error("failed to connect to the gateway; retrying", "err", err, "delay", delay)
+		time.Sleep(delay)
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// openStore returns the cabinet for the state, which is persisted under the
+// cache directory if the cache is enabled.
+func openStore() *store.Cabinet {
+	if !app.cfg.Cache.Enabled {
+		return defaultstore.New()
+	}
+
+	s, err := diskstore.New(filepath.Join(consts.CacheDir(), "store"), diskstore.Options{
+		MaxMessages: maxStoredMessages,
+		MaxAge:      time.Duration(app.cfg.Cache.MaxAgeDays) * 24 * time.Hour,
+		MaxSize:     int64(app.cfg.Cache.MaxSizeMB) << 20,
+	})
+	if err != nil {
+		slog.Error("failed to open store; falling back to memory", "err", err)
+		return defaultstore.New()
+	}
+
+	discordStore = s
+	return s.Cabinet()
+}
+
+// refreshPersistedMessages replaces the messages of the channel that were
+// persisted by a previous session with the latest ones.
+func refreshPersistedMessages(channel *discord.Channel) error {
+	messages, err := discordState.Client.Messages(channel.ID, maxStoredMessages)
+	if err != nil {
+		return err
+	}
+
+	// Messages fetched from the API do not have the guild ID set.
+	for i := range messages {
+		messages[i].GuildID = channel.GuildID
+	}
+
+	return discordStore.ReplaceMessages(channel.ID, messages)
 }
 
 func onRequest(r httpdriver.Request) error {
@@ -76,6 +199,23 @@ func onRequest(r httpdriver.Request) error {
 	return nil
 }
 
+// onResponse shows in the status bar that requests are rate limited.
+func onResponse(_ httpdriver.Request, r httpdriver.Response) error {
+	// The response is nil if the request failed.
+	if r == nil || r.GetStatus() != stdhttp.StatusTooManyRequests {
+		return nil
+	}
+
+	retryAfter, err := strconv.ParseFloat(r.GetHeader().Get("Retry-After"), 64)
+	if err != nil {
+		retryAfter = 1
+	}
+
+	slog.Warn("rate limited", "retry_after", retryAfter)
+	app.chatView.statusBar.setRateLimited(time.Duration(retryAfter * float64(time.Second)))
+	return nil
+}
+
 func onRaw(event *ws.RawEvent) {
 	slog.Debug(
 		"new raw event",
@@ -175,62 +315,193 @@ func onChannelCreate(event *gateway.ChannelCreateEvent) {
 	})
 }
 
+func onThreadCreate(event *gateway.ThreadCreateEvent) {
+	app.QueueUpdateDraw(func() {
+		app.chatView.guildsTree.addThreadNode(event.Channel)
+	})
+}
+
+func onThreadUpdate(event *gateway.ThreadUpdateEvent) {
+	app.QueueUpdateDraw(func() {
+		// The starter message shares the ID of the thread and shows its name
+		// and reply count.
+		if app.chatView.selectedChannel != nil && app.chatView.selectedChannel.ID == event.ParentID {
+			app.chatView.messagesList.redrawMessage(discord.MessageID(event.ID))
+		}
+	})
+}
+
+func onThreadDelete(event *gateway.ThreadDeleteEvent) {
+	app.QueueUpdateDraw(func() {
+		root := app.chatView.guildsTree.GetRoot()
+		root.Walk(func(node, parent *tview.TreeNode) bool {
+			if node.GetReference() == event.ID {
+				parent.RemoveChild(node)
+				return false
+			}
+
+			return true
+		})
+	})
+}
+
 var guildsTreeInitialized bool
 
 func onReady(r *gateway.ReadyEvent) {
 	slog.Info("onReady event received", "already_initialized", guildsTreeInitialized)
+	app.chatView.statusBar.setStatus(gatewayReady)
+
+	folders := r.UserSettings.GuildFolders
+	if discordStore != nil {
+		if err := discordStore.SetGuildFolders(folders); err != nil {
+			slog.Error("failed to persist guild folders", "err", err)
+		}
+	}
 
-	// Only build the tree once - don't rebuild on subsequent Ready events (reconnections)
+	// A new session was started after the connection was lost; the events
+	// that were missed meanwhile are not replayed.
 	if guildsTreeInitialized {
-		slog.Warn("IGNORING Ready event - tree already initialized, this is a reconnection")
+		slog.Info("resyncing after reconnection")
+		app.interactions.clear()
+		app.QueueUpdateDraw(func() {
+			app.chatView.resync(folders)
+		})
 		return
 	}
 
 	slog.Info("Building guilds tree from Ready event")
 	guildsTreeInitialized = true
 
-	root := app.chatView.guildsTree.GetRoot()
-	dmNode := tview.NewTreeNode("Direct Messages")
-	root.ClearChildren().AddChild(dmNode)
+	// The application is already running when started offline, so the cached
+	// tree is replaced on the UI thread and the selected channel is reopened
+	// with its latest messages.
+	if app.chatView.offline {
+		app.QueueUpdateDraw(func() {
+			slog.Info("switching to live mode")
+			app.chatView.setReconnecting(false)
+			app.chatView.setOffline(false)
+			app.chatView.guildsTree.setGuildFolders(folders)
 
-	for _, folder := range r.UserSettings.GuildFolders {
-		if folder.ID == 0 && len(folder.GuildIDs) == 1 {
-			guild, err := discordState.Cabinet.Guild(folder.GuildIDs[0])
-			if err != nil {
-				slog.Error(
-					"failed to get guild from state",
-					"guild_id",
-					folder.GuildIDs[0],
-					"err",
-					err,
-				)
-				continue
+			if channel := app.chatView.selectedChannel; channel != nil {
+				app.chatView.openChannel(channel)
 			}
-
-			app.chatView.guildsTree.createGuildNode(root, *guild)
-		} else {
-			app.chatView.guildsTree.createFolderNode(folder)
-		}
+		})
+		return
 	}
 
-	app.chatView.guildsTree.SetCurrentNode(root)
+	app.chatView.guildsTree.setGuildFolders(folders)
 	app.SetFocus(app.chatView.guildsTree)
 	app.Draw()
 }
 
+// onResumed hides the reconnecting banner; the events that were missed while
+// disconnected are replayed by Discord.
+func onResumed(*gateway.ResumedEvent) {
+	slog.Info("session resumed")
+	app.chatView.statusBar.setStatus(gatewayReady)
+	app.QueueUpdateDraw(func() {
+		app.chatView.setReconnecting(false)
+	})
+}
+
+// onClose shows the reconnecting banner when the gateway connection is lost.
+// The gateway reconnects by itself.
+func onClose(event *ws.CloseEvent) {
+	slog.Warn("gateway connection closed", "err", event.Err, "code", event.Code)
+	app.chatView.statusBar.setStatus(gatewayDisconnected)
+
+	// The connection is also closed when quitting, from the UI thread, which
+	// waits for this handler to return.
+	go app.QueueUpdateDraw(func() {
+		app.chatView.setReconnecting(true)
+	})
+}
+
+// onHello is received on every new gateway connection, before the session is
+// identified or resumed.
+func onHello(*gateway.HelloEvent) {
+	app.chatView.statusBar.setStatus(gatewayConnecting)
+}
+
+func onInvalidSession(*gateway.InvalidSessionEvent) {
+	slog.Warn("gateway session invalidated")
+	app.chatView.statusBar.resetSession()
+	app.chatView.statusBar.setStatus(gatewayConnecting)
+}
+
+func onHeartbeatAck(*gateway.HeartbeatAckEvent) {
+	app.chatView.statusBar.setLatency(discordState.Gateway().Latency())
+}
+
+// onSessionsReplace is received when the presence of the current user changes,
+// e.g. from another client. The first session holds the aggregated presence.
+func onSessionsReplace(event *gateway.SessionsReplaceEvent) {
+	if len(*event) > 0 {
+		app.chatView.statusBar.setPresence((*event)[0].Status)
+	}
+}
+
+func onTypingStart(event *gateway.TypingStartEvent) {
+	if me, err := discordState.Cabinet.Me(); err == nil && me.ID == event.UserID {
+		return
+	}
+
+	// Typing events in DMs do not include the member.
+	var name string
+	if event.Member != nil {
+		name = event.Member.Nick
+		if name == "" {
+			name = event.Member.User.DisplayOrUsername()
+		}
+	} else if channel, err := discordState.Cabinet.Channel(event.ChannelID); err == nil {
+		for _, user := range channel.DMRecipients {
+			if user.ID == event.UserID {
+				name = user.DisplayOrUsername()
+			}
+		}
+	}
+
+	if name == "" {
+		slog.Debug("unknown typing user", "user_id", event.UserID, "channel_id", event.ChannelID)
+		return
+	}
+
+	app.chatView.typingIndicator.addTyping(event.ChannelID, event.UserID, name)
+}
+
 func onMessageCreate(message *gateway.MessageCreateEvent) {
+	// Sending a message ends typing.
+	app.chatView.typingIndicator.removeTyping(message.ChannelID, message.Author.ID)
+
 	isCurrentChannel := app.chatView.selectedChannel != nil &&
 		app.chatView.selectedChannel.ID == message.ChannelID
 
+	// The message may have been sent from the outbox, in which case its local
+	// message is replaced.
+	localID, err := discord.ParseSnowflake(message.Nonce)
+	if err == nil && !app.outbox.remove(discord.MessageID(localID)) {
+		localID = 0
+	}
+
 	if isCurrentChannel {
-		app.chatView.messagesList.drawMessage(app.chatView.messagesList, message.Message)
-		app.Draw()
+		app.QueueUpdateDraw(func() {
+			if localID.IsValid() {
+				app.chatView.messagesList.confirmMessage(discord.MessageID(localID), message.Message)
+			} else {
+				app.chatView.messagesList.appendMessage(message.Message)
+			}
+		})
 
 		// Auto-mark as read when viewing the channel
 		go discordState.ReadState.MarkRead(message.ChannelID, message.ID)
 	}
 
-	if err := notifications.Notify(discordState, message, app.cfg); err != nil {
+	// Polls are not part of the event as decoded by arikawa.
+	if mayHavePoll(message.Message) && !app.polls.has(message.ID) {
+		go fetchPoll(message.ChannelID, message.ID)
+	}
+
+	if err := notifications.Notify(discordState, message, app.cfg, app.media); err != nil {
 		slog.Error("failed to notify", "err", err, "channel_id", message.ChannelID, "message_id", message.ID)
 	}
 
@@ -252,29 +523,73 @@ func onMessageCreate(message *gateway.MessageCreateEvent) {
 }
 
 func onMessageUpdate(message *gateway.MessageUpdateEvent) {
+	// The message of a poll is updated when the poll ends.
+	if app.polls.has(message.ID) {
+		go fetchPoll(message.ChannelID, message.ID)
+		return
+	}
+
 	if app.chatView.selectedChannel != nil &&
 		app.chatView.selectedChannel.ID == message.ChannelID {
-		onMessageDelete(&gateway.MessageDeleteEvent{ID: message.ID, ChannelID: message.ChannelID, GuildID: message.GuildID})
+		go updateLoadedMessage(message.ChannelID, message.ID)
 	}
 }
 
 func onMessageDelete(message *gateway.MessageDeleteEvent) {
 	if app.chatView.selectedChannel != nil &&
 		app.chatView.selectedChannel.ID == message.ChannelID {
-		messages, err := discordState.Cabinet.Messages(message.ChannelID)
-		if err != nil {
-			slog.Error("failed to get messages from state", "err", err, "channel_id", message.ChannelID)
+		app.QueueUpdateDraw(func() {
+			app.chatView.messagesList.deleteMessage(message.ID)
+		})
+	}
+}
+
+// updateLoadedMessage re-renders a message of the selected channel after the
+// state has applied an event to it, if it is loaded. Messages of older pages
+// are not held by the state, so they are fetched again instead.
+func updateLoadedMessage(channelID discord.ChannelID, messageID discord.MessageID) {
+	app.QueueUpdate(func() {
+		if app.chatView.messagesList.messageIndex(messageID) == -1 {
 			return
 		}
 
-		app.QueueUpdateDraw(func() {
-			app.chatView.messagesList.reset()
-			app.chatView.messagesList.drawMessages(messages)
-		})
+		go func() {
+			message, err := discordState.Cabinet.Message(channelID, messageID)
+			if err != nil {
+				message, err = discordState.Message(channelID, messageID)
+				if err != nil {
+					slog.Error("failed to get updated message", "err", err, "channel_id", channelID, "message_id", messageID)
+					return
+				}
+			}
+
+			app.QueueUpdateDraw(func() {
+				app.chatView.messagesList.updateMessage(*message)
+			})
+		}()
+	})
+}
+
+// redrawAuthors renders the loaded messages of the members again, as their
+// names and colors may have changed.
+func redrawAuthors(guildID discord.GuildID, userIDs ...discord.UserID) {
+	ids := make(map[discord.UserID]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		ids[id] = struct{}{}
 	}
+
+	app.QueueUpdateDraw(func() {
+		app.chatView.messagesList.redrawAuthors(guildID, ids)
+	})
 }
 
 func onGuildMembersChunk(event *gateway.GuildMembersChunkEvent) {
+	userIDs := make([]discord.UserID, len(event.Members))
+	for i, m := range event.Members {
+		userIDs[i] = m.User.ID
+	}
+	redrawAuthors(event.GuildID, userIDs...)
+
 	if app.chatView.membersList.currentGuildID == event.GuildID && app.chatView.membersList.visible {
 		app.QueueUpdateDraw(func() {
 			app.chatView.membersList.rebuildList()
@@ -283,6 +598,8 @@ func onGuildMembersChunk(event *gateway.GuildMembersChunkEvent) {
 }
 
 func onGuildMemberAdd(event *gateway.GuildMemberAddEvent) {
+	redrawAuthors(event.GuildID, event.User.ID)
+
 	if app.chatView.membersList.currentGuildID == event.GuildID && app.chatView.membersList.visible {
 		app.QueueUpdateDraw(func() {
 			app.chatView.membersList.rebuildList()
@@ -291,6 +608,8 @@ func onGuildMemberAdd(event *gateway.GuildMemberAddEvent) {
 }
 
 func onGuildMemberUpdate(event *gateway.GuildMemberUpdateEvent) {
+	redrawAuthors(event.GuildID, event.User.ID)
+
 	if app.chatView.membersList.currentGuildID == event.GuildID && app.chatView.membersList.visible {
 		app.QueueUpdateDraw(func() {
 			app.chatView.membersList.rebuildList()
@@ -298,6 +617,18 @@ func onGuildMemberUpdate(event *gateway.GuildMemberUpdateEvent) {
 	}
 }
 
+func onGuildRoleUpdate(event *gateway.GuildRoleUpdateEvent) {
+	app.QueueUpdateDraw(func() {
+		app.chatView.messagesList.redrawGuild(event.GuildID)
+	})
+}
+
+func onGuildRoleDelete(event *gateway.GuildRoleDeleteEvent) {
+	app.QueueUpdateDraw(func() {
+		app.chatView.messagesList.redrawGuild(event.GuildID)
+	})
+}
+
 func onGuildMemberRemove(event *gateway.GuildMemberRemoveEvent) {
 	if app.chatView.membersList.currentGuildID == event.GuildID && app.chatView.membersList.visible {
 		app.QueueUpdateDraw(func() {
@@ -314,19 +645,17 @@ func onPresenceUpdate(event *gateway.PresenceUpdateEvent) {
 	}
 }
 
+// The reaction events are applied to the loaded copies of the messages, as
+// the messages of older pages are not held by the state.
+
 func onMessageReactionAdd(event *gateway.MessageReactionAddEvent) {
 	if app.chatView.selectedChannel != nil &&
 		app.chatView.selectedChannel.ID == event.ChannelID {
-
-		messages, err := discordState.Cabinet.Messages(event.ChannelID)
-		if err != nil {
-			slog.Error("failed to get messages after reaction add", "err", err)
-			return
-		}
-
+		me := isMe(event.UserID)
 		app.QueueUpdateDraw(func() {
-			app.chatView.messagesList.reset()
-			app.chatView.messagesList.drawMessages(messages)
+			app.chatView.messagesList.updateReactions(event.MessageID, func(reactions []discord.Reaction) []discord.Reaction {
+				return addReaction(reactions, event.Emoji, me)
+			})
 		})
 	}
 }
@@ -334,16 +663,11 @@ func onMessageReactionAdd(event *gateway.MessageReactionAddEvent) {
 func onMessageReactionRemove(event *gateway.MessageReactionRemoveEvent) {
 	if app.chatView.selectedChannel != nil &&
 		app.chatView.selectedChannel.ID == event.ChannelID {
-
-		messages, err := discordState.Cabinet.Messages(event.ChannelID)
-		if err != nil {
-			slog.Error("failed to get messages after reaction remove", "err", err)
-			return
-		}
-
+		me := isMe(event.UserID)
 		app.QueueUpdateDraw(func() {
-			app.chatView.messagesList.reset()
-			app.chatView.messagesList.drawMessages(messages)
+			app.chatView.messagesList.updateReactions(event.MessageID, func(reactions []discord.Reaction) []discord.Reaction {
+				return removeReaction(reactions, event.Emoji, me)
+			})
 		})
 	}
 }
@@ -351,20 +675,73 @@ func onMessageReactionRemove(event *gateway.MessageReactionRemoveEvent) {
 func onMessageReactionRemoveAll(event *gateway.MessageReactionRemoveAllEvent) {
 	if app.chatView.selectedChannel != nil &&
 		app.chatView.selectedChannel.ID == event.ChannelID {
+		app.QueueUpdateDraw(func() {
+			app.chatView.messagesList.updateReactions(event.MessageID, func([]discord.Reaction) []discord.Reaction {
+				return nil
+			})
+		})
+	}
+}
 
-		messages, err := discordState.Cabinet.Messages(event.ChannelID)
-		if err != nil {
-			slog.Error("failed to get messages after reactions cleared", "err", err)
-			return
-		}
-
+func onMessageReactionRemoveEmoji(event *gateway.MessageReactionRemoveEmojiEvent) {
+	if app.chatView.selectedChannel != nil &&
+		app.chatView.selectedChannel.ID == event.ChannelID {
 		app.QueueUpdateDraw(func() {
-			app.chatView.messagesList.reset()
-			app.chatView.messagesList.drawMessages(messages)
+			app.chatView.messagesList.updateReactions(event.MessageID, func(reactions []discord.Reaction) []discord.Reaction {
+				return slices.DeleteFunc(reactions, func(r discord.Reaction) bool {
+					return sameEmoji(r.Emoji, event.Emoji)
+				})
+			})
 		})
 	}
 }
 
+// isMe reports whether the user is the current user.
+func isMe(userID discord.UserID) bool {
+	me, err := discordState.Cabinet.Me()
+	return err == nil && me.ID == userID
+}
+
+func sameEmoji(a, b discord.Emoji) bool {
+	return a.ID == b.ID && a.Name == b.Name
+}
+
+// addReaction counts a reaction with the emoji, by the current user if me is
+// true.
+func addReaction(reactions []discord.Reaction, emoji discord.Emoji, me bool) []discord.Reaction {
+	i := slices.IndexFunc(reactions, func(r discord.Reaction) bool {
+		return sameEmoji(r.Emoji, emoji)
+	})
+	if i == -1 {
+		return append(reactions, discord.Reaction{Count: 1, Me: me, Emoji: emoji})
+	}
+
+	reactions[i].Count++
+	reactions[i].Me = reactions[i].Me || me
+	return reactions
+}
+
+// removeReaction uncounts a reaction with the emoji, by the current user if
+// me is true, and drops it once nobody has reacted with it.
+func removeReaction(reactions []discord.Reaction, emoji discord.Emoji, me bool) []discord.Reaction {
+	i := slices.IndexFunc(reactions, func(r discord.Reaction) bool {
+		return sameEmoji(r.Emoji, emoji)
+	})
+	if i == -1 {
+		return reactions
+	}
+
+	if reactions[i].Count <= 1 {
+		return slices.Delete(reactions, i, i+1)
+	}
+
+	reactions[i].Count--
+	if me {
+		reactions[i].Me = false
+	}
+	return reactions
+}
+
 func initiateDM(userID discord.UserID) error {
 	// Create or get existing DM channel
 	channel, err := discordState.CreatePrivateChannel(userID)
@@ -423,10 +800,11 @@ func initiateDM(userID discord.UserID) error {
 			}
 
 			// Select the channel and display messages
+			app.chatView.messageInput.switchChannel(app.chatView.selectedChannel, channel)
 			app.chatView.selectedChannel = channel
 			app.chatView.messagesList.reset()
 			app.chatView.messagesList.setTitle(*channel)
-			app.chatView.messagesList.drawMessages(messages)
+			app.chatView.messagesList.setMessages(messages)
 			app.chatView.messagesList.ScrollToEnd()
 			app.chatView.messageInput.SetDisabled(false)
 			app.chatView.messageInput.SetPlaceholder("Message...")
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+type gatewayStatus int
+
+const (
+	gatewayConnecting gatewayStatus = iota
+	gatewayReady
+	gatewayResuming
+	gatewayDisconnected
+)
+
+func (s gatewayStatus) String() string {
+	switch s {
+	case gatewayReady:
+		return "[green]●[-] ready"
+	case gatewayResuming:
+		return "[yellow]●[-] resuming"
+	case gatewayDisconnected:
+		return "[red]●[-] disconnected"
+	default:
+		return "[yellow]●[-] connecting"
+	}
+}
+
+// statusBar shows the state of the gateway connection, the heartbeat latency,
+// the progress of a background task and the current user and their presence.
+//
+// It is fed from the gateway handlers and HTTP hooks, which run outside of the
+// UI thread, so its state is guarded by a mutex and it is drawn on the UI
+// thread afterwards.
+type statusBar struct {
+	*tview.TextView
+	cfg *config.Config
+
+	mu               sync.Mutex
+	status           gatewayStatus
+	hadSession       bool
+	latency          time.Duration
+	presence         discord.Status
+	rateLimitedUntil time.Time
+	// task is the progress of a background task, such as an export. It may
+	// contain color tags.
+	task string
+}
+
+func newStatusBar(cfg *config.Config) *statusBar {
+	sb := &statusBar{
+		TextView: tview.NewTextView(),
+		cfg:      cfg,
+		presence: cfg.Status,
+	}
+
+	sb.
+		SetDynamicColors(true).
+		SetWrap(false)
+	return sb
+}
+
+// queueDraw draws the status bar on the UI thread. It does not wait, so that
+// it can be called from the handlers of the gateway, which the UI thread may
+// be waiting for.
+func (sb *statusBar) queueDraw() {
+	go app.QueueUpdateDraw(sb.draw)
+}
+
+func (sb *statusBar) setStatus(status gatewayStatus) {
+	sb.mu.Lock()
+	switch status {
+	case gatewayConnecting:
+		// A new connection resumes the session if there was one.
+		if sb.hadSession {
+			status = gatewayResuming
+		}
+	case gatewayReady:
+		sb.hadSession = true
+	}
+	sb.status = status
+	sb.mu.Unlock()
+
+	sb.queueDraw()
+}
+
+// resetSession makes the next connection start a new session, e.g. after the
+// session was invalidated.
+func (sb *statusBar) resetSession() {
+	sb.mu.Lock()
+	sb.hadSession = false
+	sb.mu.Unlock()
+}
+
+func (sb *statusBar) setLatency(latency time.Duration) {
+	sb.mu.Lock()
+	sb.latency = latency
+	sb.mu.Unlock()
+
+	sb.queueDraw()
+}
+
+func (sb *statusBar) setPresence(presence discord.Status) {
+	sb.mu.Lock()
+	sb.presence = presence
+	sb.mu.Unlock()
+
+	sb.queueDraw()
+}
+
+// setRateLimited shows that requests are rate limited for the duration.
+func (sb *statusBar) setRateLimited(d time.Duration) {
+	sb.mu.Lock()
+	sb.rateLimitedUntil = time.Now().Add(d)
+	sb.mu.Unlock()
+
+	sb.queueDraw()
+	time.AfterFunc(d, sb.queueDraw)
+}
+
+// setTask shows the progress of a background task. An empty task hides it.
+func (sb *statusBar) setTask(task string) {
+	sb.mu.Lock()
+	sb.task = task
+	sb.mu.Unlock()
+
+	sb.queueDraw()
+}
+
+// taskResultDuration is how long the result of a background task stays in the
+// status bar.
+const taskResultDuration = 10 * time.Second
+
+// setTaskResult shows the result of a background task for a while, unless
+// another task replaces it.
+func (sb *statusBar) setTaskResult(result string) {
+	sb.setTask(result)
+	time.AfterFunc(taskResultDuration, func() {
+		sb.mu.Lock()
+		cleared := sb.task == result
+		if cleared {
+			sb.task = ""
+		}
+		sb.mu.Unlock()
+
+		if cleared {
+			sb.queueDraw()
+		}
+	})
+}
+
+func (sb *statusBar) draw() {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	parts := []string{sb.status.String()}
+	if sb.status == gatewayReady && sb.latency > 0 {
+		parts = append(parts, fmt.Sprintf("%dms", sb.latency.Milliseconds()))
+	}
+
+	if until := time.Until(sb.rateLimitedUntil); until > 0 {
+		parts = append(parts, fmt.Sprintf("[yellow]rate limited (%.1fs)[-]", until.Seconds()))
+	}
+
+	if sb.task != "" {
+		parts = append(parts, sb.task)
+	}
+
+	if me, err := discordState.Cabinet.Me(); err == nil {
+		user := tview.Escape(me.Username)
+		if sb.presence != "" {
+			user += " (" + string(sb.presence) + ")"
+		}
+		parts = append(parts, user)
+	}
+
+	sb.SetText(" " + strings.Join(parts, " [::d]|[::D] "))
+}
@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/tview"
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+// typingTimeout is how long a user is shown as typing after a typing event,
+// as documented by Discord.
+const typingTimeout = 10 * time.Second
+
+// typingIndicator shows who is typing in the selected channel on the line
+// under the messages list.
+//
+// Like the status bar, it is fed from the gateway handlers, so its state is
+// guarded by a mutex and it is drawn on the UI thread afterwards.
+type typingIndicator struct {
+	*tview.TextView
+	cfg *config.Config
+
+	mu     sync.Mutex
+	typing map[discord.ChannelID]map[discord.UserID]typingUser
+}
+
+type typingUser struct {
+	name    string
+	expires time.Time
+}
+
+func newTypingIndicator(cfg *config.Config) *typingIndicator {
+	ti := &typingIndicator{
+		TextView: tview.NewTextView(),
+		cfg:      cfg,
+		typing:   make(map[discord.ChannelID]map[discord.UserID]typingUser),
+	}
+
+	ti.
+		SetDynamicColors(true).
+		SetWrap(false)
+	return ti
+}
+
+// queueDraw draws the typing indicator on the UI thread without waiting.
+func (ti *typingIndicator) queueDraw() {
+	go app.QueueUpdateDraw(ti.draw)
+}
+
+// addTyping shows the user as typing in the channel until the typing timeout
+// expires or the user sends a message.
+func (ti *typingIndicator) addTyping(channelID discord.ChannelID, userID discord.UserID, name string) {
+	ti.mu.Lock()
+	users, ok := ti.typing[channelID]
+	if !ok {
+		users = make(map[discord.UserID]typingUser)
+		ti.typing[channelID] = users
+	}
+	users[userID] = typingUser{name: name, expires: time.Now().Add(typingTimeout)}
+	ti.mu.Unlock()
+
+	ti.queueDraw()
+	time.AfterFunc(typingTimeout, ti.queueDraw)
+}
+
+func (ti *typingIndicator) removeTyping(channelID discord.ChannelID, userID discord.UserID) {
+	ti.mu.Lock()
+	_, ok := ti.typing[channelID][userID]
+	delete(ti.typing[channelID], userID)
+	ti.mu.Unlock()
+
+	if ok {
+		ti.queueDraw()
+	}
+}
+
+// typingNames returns the names of the users typing in the channel, sorted,
+// and drops the expired ones. It must be called with the mutex held.
+func (ti *typingIndicator) typingNames(channelID discord.ChannelID) []string {
+	now := time.Now()
+	users := ti.typing[channelID]
+	maps.DeleteFunc(users, func(_ discord.UserID, u typingUser) bool {
+		return now.After(u.expires)
+	})
+
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		names = append(names, u.name)
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+func (ti *typingIndicator) draw() {
+	ti.mu.Lock()
+	defer ti.mu.Unlock()
+
+	ti.Clear()
+	if channel := app.chatView.selectedChannel; channel != nil {
+		if names := ti.typingNames(channel.ID); len(names) > 0 {
+			ti.SetText(" [::di]" + tview.Escape(typingText(names)) + "[::DI]")
+		}
+	}
+}
+
+// typingText formats the names of the users that are typing.
+func typingText(names []string) string {
+	switch len(names) {
+	case 1:
+		return names[0] + " is typing…"
+	case 2:
+		return names[0] + " and " + names[1] + " are typing…"
+	case 3:
+		return names[0] + ", " + names[1] + " and " + names[2] + " are typing…"
+	default:
+		return "Several people are typing…"
+	}
+}
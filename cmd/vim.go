@@ -0,0 +1,881 @@
+package cmd
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ayn2op/discordo/internal/clipboard"
+	"github.com/gdamore/tcell/v3"
+)
+
+// maxVimCount bounds the counts, so that a long count neither overflows nor
+// repeats a command for too long.
+const maxVimCount = 999
+
+// vimMode is the mode of the modal editing of the message input.
+type vimMode int
+
+const (
+	vimInsert vimMode = iota
+	vimNormal
+	vimVisual
+)
+
+func (m vimMode) String() string {
+	switch m {
+	case vimNormal:
+		return "NORMAL"
+	case vimVisual:
+		return "VISUAL"
+	default:
+		return "INSERT"
+	}
+}
+
+// vimRegister is the text that was yanked or deleted into a register, and
+// whether it is made of whole lines.
+type vimRegister struct {
+	text     string
+	linewise bool
+}
+
+// vimState is the state of the modal editing of the message input.
+type vimState struct {
+	mode vimMode
+
+	// count, operator and operatorCount are the parts of the command that is
+	// being typed, such as 2d3w.
+	count         int
+	operator      rune
+	operatorCount int
+	// pending is the key that waits for another one, such as g or f.
+	pending rune
+	// register is the register that was selected with ", or 0 for the unnamed
+	// one.
+	register rune
+
+	// anchor is where the visual selection started and cursor is where it
+	// ends, which may be before the anchor.
+	anchor, cursor int
+	// column is the column that j and k move the cursor to, which is kept
+	// over shorter lines while the cursor stays at columnPos.
+	column    int
+	columnPos int
+
+	registers map[rune]vimRegister
+}
+
+// resetCommand forgets the command that is being typed.
+func (v *vimState) resetCommand() {
+	v.count, v.operator, v.operatorCount = 0, 0, 0
+	v.pending, v.register = 0, 0
+}
+
+// typing reports whether a command is being typed.
+func (v *vimState) typing() bool {
+	return v.count != 0 || v.operator != 0 || v.pending != 0 || v.register != 0
+}
+
+// indicator returns the mode and the command that is being typed, which are
+// shown in the title of the input.
+func (v *vimState) indicator() string {
+	var b strings.Builder
+	b.WriteString(v.mode.String())
+
+	var command string
+	if v.register != 0 {
+		command += `"` + string(v.register)
+	}
+	if v.operatorCount > 0 {
+		command += strconv.Itoa(v.operatorCount)
+	}
+	if v.operator != 0 {
+		command += string(v.operator)
+	}
+	if v.count > 0 {
+		command += strconv.Itoa(v.count)
+	}
+	if v.pending != 0 {
+		command += string(v.pending)
+	}
+
+	if command != "" {
+		b.WriteString(" " + command)
+	}
+	return b.String()
+}
+
+// totalCount returns the count of the motion multiplied by the one of the
+// operator, or 0 if neither was typed.
+func (v *vimState) totalCount() int {
+	if v.operatorCount == 0 {
+		return v.count
+	}
+	return min(max(v.count, 1)*v.operatorCount, maxVimCount)
+}
+
+// vimShorthands are the commands that are short for an operator and a motion.
+var vimShorthands = map[rune]struct {
+	operator, motion rune
+}{
+	'x': {'d', 'l'},
+	'X': {'d', 'h'},
+	'D': {'d', '$'},
+	'C': {'c', '$'},
+	's': {'c', 'l'},
+}
+
+// vimMotion is where a motion moves the cursor to, and how the text up to
+// there is operated on.
+type vimMotion struct {
+	pos       int
+	linewise  bool
+	inclusive bool
+}
+
+// onVimInputCapture handles the keys of the normal and visual modes. It
+// returns the event if it is left to the rest of the input handling.
+func (mi *messageInput) onVimInputCapture(event *tcell.EventKey) *tcell.EventKey {
+	v := &mi.vim
+	if v.mode == vimInsert {
+		if event.Name() != mi.cfg.Keys.MessageInput.Vim.NormalMode || app.chatView.GetVisibile(mentionsListPageName) {
+			return event
+		}
+
+		// The cursor moves back onto the last inserted character, as in vim.
+		text, pos := mi.GetText(), mi.vimCursor()
+		if pos > vimLineStart(text, pos) {
+			pos = vimPrevRune(text, pos)
+		}
+
+		mi.setVimMode(vimNormal)
+		mi.vimMoveCursor(text, pos)
+		return nil
+	}
+
+	defer mi.setTitle(mi.title)
+
+	if event.Key() != tcell.KeyRune || event.Modifiers()&^tcell.ModShift != 0 {
+		if event.Name() == mi.cfg.Keys.MessageInput.Vim.NormalMode && (v.mode == vimVisual || v.typing()) {
+			mi.setVimMode(vimNormal)
+			return nil
+		}
+
+		// The other keys, such as sending the message, work as in the insert
+		// mode.
+		if v.mode == vimVisual {
+			mi.setVimMode(vimNormal)
+		}
+		v.resetCommand()
+		return event
+	}
+
+	r, _ := utf8.DecodeRuneInString(event.Str())
+	return mi.vimKey(r)
+}
+
+// setVimMode switches to the mode, forgetting the command that was being typed.
+func (mi *messageInput) setVimMode(mode vimMode) {
+	v := &mi.vim
+	switch {
+	case v.mode == vimVisual && mode != vimVisual:
+		pos := mi.vimCursor()
+		mi.Select(pos, pos)
+	case v.mode != vimVisual && mode == vimVisual:
+		v.cursor = mi.vimCursor()
+		v.anchor = v.cursor
+	}
+
+	v.mode = mode
+	v.columnPos = -1
+	v.resetCommand()
+	mi.setTitle(mi.title)
+}
+
+// vimCursor returns the position of the cursor in the text.
+func (mi *messageInput) vimCursor() int {
+	if mi.vim.mode == vimVisual {
+		return min(mi.vim.cursor, mi.GetTextLength())
+	}
+
+	_, start, _ := mi.GetSelection()
+	return start
+}
+
+// vimMoveCursor moves the cursor to the position, or onto the last character
+// of the line in the normal mode, or extends the selection in the visual mode.
+func (mi *messageInput) vimMoveCursor(text string, pos int) {
+	if mi.vim.mode == vimVisual {
+		mi.vim.cursor = pos
+		start, end := min(mi.vim.anchor, pos), max(mi.vim.anchor, pos)
+		if end < len(text) {
+			end = vimNextRune(text, end)
+		}
+		mi.Select(start, end)
+		return
+	}
+
+	if end := vimLineEnd(text, pos); pos >= end && pos > vimLineStart(text, pos) {
+		pos = vimPrevRune(text, end)
+	}
+	mi.Select(pos, pos)
+}
+
+// vimKey handles a key typed in the normal or visual mode. It returns the
+// event that the text area handles instead, if any.
+func (mi *messageInput) vimKey(r rune) *tcell.EventKey {
+	v := &mi.vim
+	text, pos := mi.GetText(), mi.vimCursor()
+
+	switch v.pending {
+	case '"':
+		v.pending = 0
+		v.register = r
+		return nil
+	case 'r':
+		mi.vimReplaceChars(text, pos, r)
+		v.resetCommand()
+		return nil
+	case 'g':
+		v.pending = 0
+		if r != 'g' {
+			v.resetCommand()
+			return nil
+		}
+		mi.vimMotion(text, pos, 'g', 0)
+		return nil
+	case 'f', 'F', 't', 'T':
+		key := v.pending
+		v.pending = 0
+		mi.vimMotion(text, pos, key, r)
+		return nil
+	}
+
+	if r >= '1' && r <= '9' || r == '0' && v.count > 0 {
+		v.count = min(v.count*10+int(r-'0'), maxVimCount)
+		return nil
+	}
+
+	switch r {
+	case '"', 'g', 'f', 'F', 't', 'T':
+		v.pending = r
+		return nil
+	case 'd', 'c', 'y', 'x', 's':
+		if v.mode == vimVisual {
+			op := r
+			switch r {
+			case 'x':
+				op = 'd'
+			case 's':
+				op = 'c'
+			}
+			mi.vimOperateVisual(text, op)
+			return nil
+		}
+
+		if r == 'x' || r == 's' {
+			break
+		}
+
+		switch v.operator {
+		case 0:
+			v.operator, v.operatorCount, v.count = r, v.count, 0
+		case r:
+			// A doubled operator operates on whole lines.
+			m := vimMotion{pos: vimLineDown(text, pos, max(v.totalCount(), 1)-1), linewise: true}
+			mi.vimOperate(text, pos, r, m)
+			v.resetCommand()
+		default:
+			v.resetCommand()
+		}
+		return nil
+	}
+
+	if v.operator == 0 {
+		if event, ok := mi.vimCommand(text, pos, r); ok {
+			return event
+		}
+	}
+
+	mi.vimMotion(text, pos, r, 0)
+	return nil
+}
+
+// vimCommand runs the command of the key that is not a motion nor an
+// operator, and reports whether it is one.
+func (mi *messageInput) vimCommand(text string, pos int, r rune) (*tcell.EventKey, bool) {
+	v := &mi.vim
+	if v.mode == vimVisual {
+		switch r {
+		case 'v':
+			mi.setVimMode(vimNormal)
+		case 'o':
+			v.anchor, v.cursor = v.cursor, v.anchor
+			mi.vimMoveCursor(text, v.cursor)
+		default:
+			return nil, false
+		}
+		return nil, true
+	}
+
+	if s, ok := vimShorthands[r]; ok {
+		v.operator, v.operatorCount, v.count = s.operator, v.count, 0
+		mi.vimMotion(text, pos, s.motion, 0)
+		return nil, true
+	}
+
+	switch r {
+	case 'S', 'Y':
+		op := 'c'
+		if r == 'Y' {
+			op = 'y'
+		}
+		m := vimMotion{pos: vimLineDown(text, pos, max(v.count, 1)-1), linewise: true}
+		mi.vimOperate(text, pos, op, m)
+	case 'p', 'P':
+		mi.vimPut(text, pos, r == 'P', max(v.count, 1))
+	case 'r':
+		v.pending = r
+		return nil, true
+	case 'i':
+		mi.setVimMode(vimInsert)
+	case 'a':
+		if pos < vimLineEnd(text, pos) {
+			pos = vimNextRune(text, pos)
+		}
+		mi.setVimMode(vimInsert)
+		mi.Select(pos, pos)
+	case 'I':
+		mi.setVimMode(vimInsert)
+		pos = vimFirstNonBlank(text, vimLineStart(text, pos))
+		mi.Select(pos, pos)
+	case 'A':
+		mi.setVimMode(vimInsert)
+		pos = vimLineEnd(text, pos)
+		mi.Select(pos, pos)
+	case 'o':
+		mi.setVimMode(vimInsert)
+		end := vimLineEnd(text, pos)
+		mi.Replace(end, end, "\n")
+	case 'O':
+		mi.setVimMode(vimInsert)
+		start := vimLineStart(text, pos)
+		mi.Replace(start, start, "\n")
+		mi.Select(start, start)
+	case 'v':
+		mi.setVimMode(vimVisual)
+		mi.vimMoveCursor(text, pos)
+	case 'u':
+		// The text area undoes the changes, including those made in the
+		// normal mode.
+		v.resetCommand()
+		return tcell.NewEventKey(tcell.KeyCtrlZ, "", tcell.ModNone), true
+	default:
+		return nil, false
+	}
+
+	v.resetCommand()
+	return nil, true
+}
+
+// vimMotion moves the cursor with the motion of the key, or operates on the
+// text up to where it moves to if an operator is pending. arg is the
+// character that f, F, t and T find.
+func (mi *messageInput) vimMotion(text string, pos int, key, arg rune) {
+	v := &mi.vim
+	defer v.resetCommand()
+
+	if v.operator == 0 {
+		m, ok := vimMotionOf(text, pos, key, arg, v.totalCount())
+		if !ok {
+			return
+		}
+
+		column := utf8.RuneCountInString(text[vimLineStart(text, pos):pos])
+		if (key == 'j' || key == 'k') && pos == v.columnPos {
+			column = v.column
+		}
+		if key == 'j' || key == 'k' {
+			m.pos = vimColumn(text, vimLineStart(text, m.pos), column)
+		}
+
+		mi.vimMoveCursor(text, m.pos)
+
+		pos = mi.vimCursor()
+		switch key {
+		case 'j', 'k':
+			v.column = column
+		case '$':
+			v.column = math.MaxInt
+		default:
+			v.column = utf8.RuneCountInString(text[vimLineStart(text, pos):pos])
+		}
+		v.columnPos = pos
+		return
+	}
+
+	// cw changes up to the end of the word, as in vim.
+	if v.operator == 'c' && pos < len(text) && vimRuneClass(vimRuneAt(text, pos), false) != 0 {
+		switch key {
+		case 'w':
+			key = 'e'
+		case 'W':
+			key = 'E'
+		}
+	}
+
+	m, ok := vimMotionOf(text, pos, key, arg, v.totalCount())
+	if !ok {
+		return
+	}
+
+	// An operator stops at the end of the line of the last word that it moves
+	// over, rather than at the start of the next line.
+	if (key == 'w' || key == 'W') && m.pos > pos {
+		if lineStart := vimLineStart(text, m.pos); lineStart > pos && strings.TrimSpace(text[lineStart:m.pos]) == "" {
+			m.pos = lineStart - 1
+		}
+	}
+
+	mi.vimOperate(text, pos, v.operator, m)
+}
+
+// vimOperate runs the operator on the text between the cursor and where the
+// motion moves it to.
+func (mi *messageInput) vimOperate(text string, pos int, op rune, m vimMotion) {
+	start, end := min(pos, m.pos), max(pos, m.pos)
+	switch {
+	case m.linewise:
+		start, end = vimLineStart(text, start), vimLineEnd(text, end)
+	case m.inclusive && end < len(text):
+		end = vimNextRune(text, end)
+	case start == end:
+		return
+	}
+
+	mi.vim.columnPos = -1
+
+	mi.vimStore(op, vimRegister{text: text[start:end], linewise: m.linewise})
+
+	switch op {
+	case 'y':
+		mi.vimMoveCursor(text, min(pos, m.pos))
+	case 'd':
+		if m.linewise {
+			// The line break of the lines is deleted too.
+			if end < len(text) {
+				end++
+			} else if start > 0 {
+				start--
+			}
+		}
+
+		mi.Replace(start, end, "")
+		text = mi.GetText()
+		if m.linewise {
+			start = vimFirstNonBlank(text, vimLineStart(text, start))
+		}
+		mi.vimMoveCursor(text, start)
+	case 'c':
+		mi.Replace(start, end, "")
+		mi.setVimMode(vimInsert)
+	}
+}
+
+// vimOperateVisual runs the operator on the selection and returns to the
+// normal mode.
+func (mi *messageInput) vimOperateVisual(text string, op rune) {
+	v := &mi.vim
+	start, end := min(v.anchor, v.cursor), max(v.anchor, v.cursor)
+	if end < len(text) {
+		end = vimNextRune(text, end)
+	}
+
+	mi.vimStore(op, vimRegister{text: text[start:end]})
+	mi.setVimMode(vimNormal)
+
+	switch op {
+	case 'y':
+		mi.vimMoveCursor(text, start)
+	case 'd':
+		mi.Replace(start, end, "")
+		mi.vimMoveCursor(mi.GetText(), start)
+	case 'c':
+		mi.Replace(start, end, "")
+		mi.setVimMode(vimInsert)
+	}
+}
+
+// vimReplaceChars replaces the characters from the cursor with the rune, as
+// many as the count, if the line has enough of them.
+func (mi *messageInput) vimReplaceChars(text string, pos int, r rune) {
+	n := max(mi.vim.count, 1)
+	end := pos
+	for range n {
+		if end >= vimLineEnd(text, pos) {
+			return
+		}
+		end = vimNextRune(text, end)
+	}
+
+	mi.vim.columnPos = -1
+	mi.Replace(pos, end, strings.Repeat(string(r), n))
+	mi.vimMoveCursor(mi.GetText(), vimPrevRune(mi.GetText(), pos+n*utf8.RuneLen(r)))
+}
+
+// vimPut inserts the text of the selected register after the cursor, or
+// before it, as many times as the count. The lines of a linewise register are
+// inserted below or above the line of the cursor.
+func (mi *messageInput) vimPut(text string, pos int, before bool, n int) {
+	reg, ok := mi.vimLoad()
+	if !ok || reg.text == "" && !reg.linewise {
+		return
+	}
+
+	mi.vim.columnPos = -1
+
+	if reg.linewise {
+		lines := strings.Repeat(reg.text+"\n", n)
+		var at int
+		if before {
+			at = vimLineStart(text, pos)
+		} else {
+			at = vimLineEnd(text, pos)
+			lines = "\n" + strings.TrimSuffix(lines, "\n")
+		}
+
+		mi.Replace(at, at, lines)
+		if !before {
+			at++
+		}
+		mi.vimMoveCursor(mi.GetText(), vimFirstNonBlank(mi.GetText(), at))
+		return
+	}
+
+	at := pos
+	if !before && pos < vimLineEnd(text, pos) {
+		at = vimNextRune(text, pos)
+	}
+
+	s := strings.Repeat(reg.text, n)
+	mi.Replace(at, at, s)
+	mi.vimMoveCursor(mi.GetText(), vimPrevRune(mi.GetText(), at+len(s)))
+}
+
+// vimStore stores the text that was yanked or deleted in the selected
+// register, and in the unnamed one. The + and * registers are the system
+// clipboard, which is also the unnamed register if it is configured so.
+func (mi *messageInput) vimStore(op rune, reg vimRegister) {
+	v := &mi.vim
+	if v.registers == nil {
+		v.registers = make(map[rune]vimRegister)
+	}
+
+	switch name := v.register; {
+	case name == '_':
+		return
+	case name == '+' || name == '*':
+		writeVimClipboard(reg)
+	case name >= 'a' && name <= 'z':
+		v.registers[name] = reg
+	case name >= 'A' && name <= 'Z':
+		// The uppercase registers append to the lowercase ones.
+		name = unicode.ToLower(name)
+		if prev, ok := v.registers[name]; ok {
+			if prev.linewise || reg.linewise {
+				reg = vimRegister{text: prev.text + "\n" + reg.text, linewise: true}
+			} else {
+				reg.text = prev.text + reg.text
+			}
+		}
+		v.registers[name] = reg
+	case name == 0 && mi.cfg.Keys.MessageInput.Vim.SystemClipboard:
+		writeVimClipboard(reg)
+	}
+
+	v.registers['"'] = reg
+	if op == 'y' {
+		v.registers['0'] = reg
+	}
+}
+
+// vimLoad returns the content of the selected register.
+func (mi *messageInput) vimLoad() (vimRegister, bool) {
+	name := mi.vim.register
+	if name == '+' || name == '*' || name == 0 && mi.cfg.Keys.MessageInput.Vim.SystemClipboard {
+		text := string(clipboard.Read(clipboard.FmtText))
+		if text == "" {
+			return vimRegister{}, false
+		}
+
+		// Text that ends with a line break is put as lines, as in vim.
+		trimmed, linewise := strings.CutSuffix(text, "\n")
+		return vimRegister{text: trimmed, linewise: linewise}, true
+	}
+
+	if name == 0 {
+		name = '"'
+	}
+	reg, ok := mi.vim.registers[unicode.ToLower(name)]
+	return reg, ok
+}
+
+func writeVimClipboard(reg vimRegister) {
+	text := reg.text
+	if reg.linewise {
+		text += "\n"
+	}
+	go clipboard.Write(clipboard.FmtText, []byte(text))
+}
+
+// vimMotionOf returns where the motion of the key moves the cursor from pos,
+// repeated count times, and whether the key is a motion that can move.
+func vimMotionOf(text string, pos int, key, arg rune, count int) (vimMotion, bool) {
+	n := max(count, 1)
+	lineStart, lineEnd := vimLineStart(text, pos), vimLineEnd(text, pos)
+
+	switch key {
+	case 'h':
+		for i := 0; i < n && pos > lineStart; i++ {
+			pos = vimPrevRune(text, pos)
+		}
+		return vimMotion{pos: pos}, true
+	case 'l', ' ':
+		for i := 0; i < n && pos < lineEnd; i++ {
+			pos = vimNextRune(text, pos)
+		}
+		return vimMotion{pos: pos}, true
+	case 'j', 'k':
+		column := utf8.RuneCountInString(text[lineStart:pos])
+		if key == 'j' {
+			pos = vimLineDown(text, pos, n)
+		} else {
+			pos = vimLineUp(text, pos, n)
+		}
+		return vimMotion{pos: vimColumn(text, pos, column), linewise: true}, true
+	case 'w', 'W', 'b', 'B', 'e', 'E':
+		big := unicode.IsUpper(key)
+		inclusive := key == 'e' || key == 'E'
+		for range n {
+			prev := pos
+			switch unicode.ToLower(key) {
+			case 'w':
+				pos = vimNextWordStart(text, pos, big)
+			case 'b':
+				pos = vimPrevWordStart(text, pos, big)
+			case 'e':
+				pos = vimWordEnd(text, pos, big)
+			}
+
+			// The start or the end of the text is reached.
+			if pos == prev {
+				break
+			}
+		}
+		return vimMotion{pos: pos, inclusive: inclusive}, true
+	case '0':
+		return vimMotion{pos: lineStart}, true
+	case '^':
+		return vimMotion{pos: vimFirstNonBlank(text, lineStart)}, true
+	case '$':
+		return vimMotion{pos: vimLineEnd(text, vimLineDown(text, pos, n-1))}, true
+	case 'g', 'G':
+		// gg moves to the first line, G to the last one, or both to the line
+		// of the count.
+		line := count
+		if count == 0 && key == 'g' {
+			line = 1
+		}
+
+		pos = 0
+		if line == 0 {
+			pos = vimLineStart(text, len(text))
+		} else {
+			pos = vimLineDown(text, 0, line-1)
+		}
+		return vimMotion{pos: vimFirstNonBlank(text, pos), linewise: true}, true
+	case 'f', 't':
+		for range n {
+			if pos >= lineEnd {
+				return vimMotion{}, false
+			}
+
+			from := vimNextRune(text, pos)
+			i := strings.IndexRune(text[from:lineEnd], arg)
+			if i == -1 {
+				return vimMotion{}, false
+			}
+			pos = from + i
+		}
+		if key == 't' {
+			pos = vimPrevRune(text, pos)
+		}
+		return vimMotion{pos: pos, inclusive: true}, true
+	case 'F', 'T':
+		for range n {
+			i := strings.LastIndex(text[lineStart:pos], string(arg))
+			if i == -1 {
+				return vimMotion{}, false
+			}
+			pos = lineStart + i
+		}
+		if key == 'T' {
+			pos = vimNextRune(text, pos)
+		}
+		return vimMotion{pos: pos}, true
+	}
+
+	return vimMotion{}, false
+}
+
+// vimLineStart returns the position of the start of the line of pos.
+func vimLineStart(text string, pos int) int {
+	return strings.LastIndexByte(text[:pos], '\n') + 1
+}
+
+// vimLineEnd returns the position of the line break that ends the line of
+// pos, or the length of the text on the last line.
+func vimLineEnd(text string, pos int) int {
+	if i := strings.IndexByte(text[pos:], '\n'); i != -1 {
+		return pos + i
+	}
+	return len(text)
+}
+
+// vimLineDown returns the start of the line that is n lines below the one of
+// pos, or of the last line.
+func vimLineDown(text string, pos, n int) int {
+	pos = vimLineStart(text, pos)
+	for range n {
+		end := vimLineEnd(text, pos)
+		if end == len(text) {
+			break
+		}
+		pos = end + 1
+	}
+	return pos
+}
+
+// vimLineUp returns the start of the line that is n lines above the one of
+// pos, or of the first line.
+func vimLineUp(text string, pos, n int) int {
+	pos = vimLineStart(text, pos)
+	for i := 0; i < n && pos > 0; i++ {
+		pos = vimLineStart(text, pos-1)
+	}
+	return pos
+}
+
+// vimColumn returns the position of the character at the column of the line
+// that starts at pos, or of the end of the line if it is shorter.
+func vimColumn(text string, pos, column int) int {
+	end := vimLineEnd(text, pos)
+	for i := 0; i < column && pos < end; i++ {
+		pos = vimNextRune(text, pos)
+	}
+	return pos
+}
+
+// vimFirstNonBlank returns the position of the first character of the line
+// that starts at pos that is not a space.
+func vimFirstNonBlank(text string, pos int) int {
+	end := vimLineEnd(text, pos)
+	for pos < end && (text[pos] == ' ' || text[pos] == '\t') {
+		pos++
+	}
+	return pos
+}
+
+func vimRuneAt(text string, pos int) rune {
+	r, _ := utf8.DecodeRuneInString(text[pos:])
+	return r
+}
+
+func vimNextRune(text string, pos int) int {
+	_, size := utf8.DecodeRuneInString(text[pos:])
+	return pos + size
+}
+
+func vimPrevRune(text string, pos int) int {
+	_, size := utf8.DecodeLastRuneInString(text[:pos])
+	return pos - size
+}
+
+// vimRuneClass returns 0 for spaces, 1 for the characters of words and 2 for
+// the others. Every character that is not a space is part of a WORD.
+func vimRuneClass(r rune, big bool) int {
+	switch {
+	case unicode.IsSpace(r):
+		return 0
+	case big || unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+		return 1
+	default:
+		return 2
+	}
+}
+
+// vimNextWordStart returns the start of the word after the one at pos.
+func vimNextWordStart(text string, pos int, big bool) int {
+	if pos >= len(text) {
+		return pos
+	}
+
+	class := vimRuneClass(vimRuneAt(text, pos), big)
+	pos = vimNextRune(text, pos)
+	for class != 0 && pos < len(text) && vimRuneClass(vimRuneAt(text, pos), big) == class {
+		pos = vimNextRune(text, pos)
+	}
+	for pos < len(text) && vimRuneClass(vimRuneAt(text, pos), big) == 0 {
+		pos = vimNextRune(text, pos)
+	}
+	return pos
+}
+
+// vimPrevWordStart returns the start of the word before pos.
+func vimPrevWordStart(text string, pos int, big bool) int {
+	if pos == 0 {
+		return 0
+	}
+
+	pos = vimPrevRune(text, pos)
+	for pos > 0 && vimRuneClass(vimRuneAt(text, pos), big) == 0 {
+		pos = vimPrevRune(text, pos)
+	}
+
+	class := vimRuneClass(vimRuneAt(text, pos), big)
+	for pos > 0 {
+		prev := vimPrevRune(text, pos)
+		if vimRuneClass(vimRuneAt(text, prev), big) != class {
+			break
+		}
+		pos = prev
+	}
+	return pos
+}
+
+// vimWordEnd returns the last character of the word after pos.
+func vimWordEnd(text string, pos int, big bool) int {
+	if pos >= len(text) {
+		return pos
+	}
+
+	pos = vimNextRune(text, pos)
+	for pos < len(text) && vimRuneClass(vimRuneAt(text, pos), big) == 0 {
+		pos = vimNextRune(text, pos)
+	}
+	if pos >= len(text) {
+		return vimPrevRune(text, pos)
+	}
+
+	class := vimRuneClass(vimRuneAt(text, pos), big)
+	for {
+		next := vimNextRune(text, pos)
+		if next >= len(text) || vimRuneClass(vimRuneAt(text, next), big) != class {
+			return pos
+		}
+		pos = next
+	}
+}
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/tview"
+)
+
+func TestVimMotionOf(t *testing.T) {
+	const (
+		words = "foo bar.baz qux"
+		lines = "one\n  two\nthree"
+	)
+
+	tests := []struct {
+		name  string
+		text  string
+		pos   int
+		key   rune
+		arg   rune
+		count int
+		want  vimMotion
+		ok    bool
+	}{
+		{"h", words, 4, 'h', 0, 0, vimMotion{pos: 3}, true},
+		{"h stops at line start", lines, 6, 'h', 0, 10, vimMotion{pos: 4}, true},
+		{"l", words, 0, 'l', 0, 2, vimMotion{pos: 2}, true},
+		{"l stops at line end", lines, 0, 'l', 0, maxVimCount, vimMotion{pos: 3}, true},
+		{"w", words, 0, 'w', 0, 0, vimMotion{pos: 4}, true},
+		{"w stops at punctuation", words, 0, 'w', 0, 2, vimMotion{pos: 7}, true},
+		{"W", words, 0, 'W', 0, 2, vimMotion{pos: 12}, true},
+		{"w stops at end of text", words, 0, 'w', 0, maxVimCount, vimMotion{pos: len(words)}, true},
+		{"b", words, 12, 'b', 0, 0, vimMotion{pos: 8}, true},
+		{"B", words, 12, 'B', 0, 0, vimMotion{pos: 4}, true},
+		{"b stops at start of text", words, 12, 'b', 0, maxVimCount, vimMotion{pos: 0}, true},
+		{"e", words, 0, 'e', 0, 0, vimMotion{pos: 2, inclusive: true}, true},
+		{"e from end of word", words, 2, 'e', 0, 0, vimMotion{pos: 6, inclusive: true}, true},
+		{"E", words, 4, 'E', 0, 0, vimMotion{pos: 10, inclusive: true}, true},
+		{"e stops at end of text", words, 0, 'e', 0, maxVimCount, vimMotion{pos: len(words) - 1, inclusive: true}, true},
+		{"f", words, 0, 'f', 'a', 0, vimMotion{pos: 5, inclusive: true}, true},
+		{"2f", words, 0, 'f', 'a', 2, vimMotion{pos: 9, inclusive: true}, true},
+		{"f not found", words, 0, 'f', 'a', 3, vimMotion{}, false},
+		{"f stays on line", lines, 0, 'f', 't', 0, vimMotion{}, false},
+		{"t", words, 0, 't', 'a', 0, vimMotion{pos: 4, inclusive: true}, true},
+		{"F", words, 4, 'F', 'o', 0, vimMotion{pos: 2}, true},
+		{"T", words, 4, 'T', 'o', 0, vimMotion{pos: 3}, true},
+		{"0", words, 6, '0', 0, 0, vimMotion{pos: 0}, true},
+		{"^", lines, 9, '^', 0, 0, vimMotion{pos: 6}, true},
+		{"$", words, 0, '$', 0, 0, vimMotion{pos: len(words)}, true},
+		{"2$", lines, 0, '$', 0, 2, vimMotion{pos: 9}, true},
+		{"j", lines, 1, 'j', 0, 0, vimMotion{pos: 5, linewise: true}, true},
+		{"2j", lines, 1, 'j', 0, 2, vimMotion{pos: 11, linewise: true}, true},
+		{"j stops at last line", lines, 0, 'j', 0, maxVimCount, vimMotion{pos: 10, linewise: true}, true},
+		{"k", lines, 11, 'k', 0, 0, vimMotion{pos: 5, linewise: true}, true},
+		{"gg", lines, 11, 'g', 0, 0, vimMotion{pos: 0, linewise: true}, true},
+		{"2gg", lines, 0, 'g', 0, 2, vimMotion{pos: 6, linewise: true}, true},
+		{"G", lines, 0, 'G', 0, 0, vimMotion{pos: 10, linewise: true}, true},
+		{"unknown", words, 0, 'z', 0, 0, vimMotion{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := vimMotionOf(tt.text, tt.pos, tt.key, tt.arg, tt.count)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("vimMotionOf() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func newTestVimInput(text string, pos int) *messageInput {
+	mi := &messageInput{TextArea: tview.NewTextArea(), cfg: &config.Config{}}
+	mi.SetText(text, false)
+	mi.Select(pos, pos)
+	mi.vim.mode = vimNormal
+	return mi
+}
+
+func TestVimOperate(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		pos      int
+		op       rune
+		key, arg rune
+		count    int
+		want     string
+		wantPos  int
+		wantReg  vimRegister
+	}{
+		{"dw", "foo bar baz", 0, 'd', 'w', 0, 0, "bar baz", 0, vimRegister{text: "foo "}},
+		{"d2w", "foo bar baz", 0, 'd', 'w', 0, 2, "baz", 0, vimRegister{text: "foo bar "}},
+		{"de", "foo bar baz", 0, 'd', 'e', 0, 0, " bar baz", 0, vimRegister{text: "foo"}},
+		{"db", "foo bar", 4, 'd', 'b', 0, 0, "bar", 0, vimRegister{text: "foo "}},
+		{"dfa", "foo bar", 0, 'd', 'f', 'a', 0, "r", 0, vimRegister{text: "foo ba"}},
+		{"d$", "foo bar\nbaz", 4, 'd', '$', 0, 0, "foo \nbaz", 3, vimRegister{text: "bar"}},
+		{"dh at line start", "foo", 0, 'd', 'h', 0, 0, "foo", 0, vimRegister{}},
+		{"dj", "one\ntwo\nthree", 0, 'd', 'j', 0, 0, "three", 0, vimRegister{text: "one\ntwo", linewise: true}},
+		{"dj to last line", "one\ntwo\n  three", 4, 'd', 'j', 0, 0, "one", 0, vimRegister{text: "two\n  three", linewise: true}},
+		{"dj keeps indent", "one\ntwo\n  three", 0, 'd', 'j', 0, 0, "  three", 2, vimRegister{text: "one\ntwo", linewise: true}},
+		{"yw", "foo bar", 0, 'y', 'w', 0, 0, "foo bar", 0, vimRegister{text: "foo "}},
+		{"yb", "foo bar", 4, 'y', 'b', 0, 0, "foo bar", 0, vimRegister{text: "foo "}},
+		{"cw", "foo bar", 0, 'c', 'e', 0, 0, " bar", 0, vimRegister{text: "foo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mi := newTestVimInput(tt.text, tt.pos)
+			m, ok := vimMotionOf(tt.text, tt.pos, tt.key, tt.arg, tt.count)
+			if !ok {
+				t.Fatalf("vimMotionOf() = %+v, false", m)
+			}
+
+			mi.vimOperate(tt.text, tt.pos, tt.op, m)
+
+			if got := mi.GetText(); got != tt.want {
+				t.Errorf("text = %q, want %q", got, tt.want)
+			}
+			if got := mi.vimCursor(); got != tt.wantPos {
+				t.Errorf("cursor = %d, want %d", got, tt.wantPos)
+			}
+			if got := mi.vim.registers['"']; got != tt.wantReg {
+				t.Errorf("register = %+v, want %+v", got, tt.wantReg)
+			}
+		})
+	}
+}
@@ -28,11 +28,30 @@ type (
 		Sound       Sound `toml:"sound"`
 	}
 
+	Cache struct {
+		Enabled    bool `toml:"enabled"`
+		MaxAgeDays uint `toml:"max_age_days"`
+		MaxSizeMB  uint `toml:"max_size_mb"`
+
+		MediaMaxAgeDays uint `toml:"media_max_age_days"`
+		MediaMaxSizeMB  uint `toml:"media_max_size_mb"`
+	}
+
 	Sound struct {
 		Enabled    bool `toml:"enabled"`
 		OnlyOnPing bool `toml:"only_on_ping"`
 	}
 
+	ImagePreviews struct {
+		Enabled bool `toml:"enabled"`
+		// "auto", "kitty", "iterm2", "sixel" or "halfblock"
+		Protocol    string `toml:"protocol"`
+		MaxWidth    uint8  `toml:"max_width"`
+		MaxHeight   uint8  `toml:"max_height"`
+		CustomEmoji bool   `toml:"custom_emoji"`
+		CacheSizeMB uint   `toml:"cache_size_mb"`
+	}
+
 	Config struct {
 		AutoFocus bool   `toml:"auto_focus"`
 		Mouse     bool   `toml:"mouse"`
@@ -43,6 +62,8 @@ type (
 		Markdown            bool `toml:"markdown"`
 		HideBlockedUsers    bool `toml:"hide_blocked_users"`
 		ShowAttachmentLinks bool `toml:"show_attachment_links"`
+		ShowStatusBar       bool `toml:"show_status_bar"`
+		SendTyping          bool `toml:"send_typing"`
 
 		// Use 0 to disable
 		AutocompleteLimit uint8 `toml:"autocomplete_limit"`
@@ -50,6 +71,8 @@ type (
 
 		Timestamps    Timestamps    `toml:"timestamps"`
 		Notifications Notifications `toml:"notifications"`
+		Cache         Cache         `toml:"cache"`
+		ImagePreviews ImagePreviews `toml:"image_previews"`
 
 		Keys  Keys  `toml:"keys"`
 		Theme Theme `toml:"theme"`
@@ -22,6 +22,7 @@ type (
 		ToggleMute        string `toml:"toggle_mute"`
 		JoinServer        string `toml:"join_server"`
 		ShowPinnedMessages string `toml:"show_pinned_messages"`
+		SearchMessages     string `toml:"search_messages"`
 
 		GuildsTree   GuildsTreeKeys   `toml:"guilds_tree"`
 		MessagesList MessagesListKeys `toml:"messages_list"`
@@ -29,6 +30,7 @@ type (
 		MentionsList MentionsListKeys `toml:"mentions_list"`
 		MembersList  MembersListKeys  `toml:"members_list"`
 		FriendsList  FriendsListKeys  `toml:"friends_list"`
+		Search       SearchKeys       `toml:"search"`
 
 		Logout string `toml:"logout"`
 		Quit   string `toml:"quit"`
@@ -51,14 +53,29 @@ type (
 		Reply        string `toml:"reply"`
 		ReplyMention string `toml:"reply_mention"`
 
-		Cancel        string `toml:"cancel"`
-		Edit          string `toml:"edit"`
-		Delete        string `toml:"delete"`
-		DeleteConfirm string `toml:"delete_confirm"`
-		Open          string `toml:"open"`
-		AddReaction   string `toml:"add_reaction"`
-		PinMessage    string `toml:"pin_message"`
-		UnpinMessage  string `toml:"unpin_message"`
+		Cancel         string `toml:"cancel"`
+		Edit           string `toml:"edit"`
+		Delete         string `toml:"delete"`
+		DeleteConfirm  string `toml:"delete_confirm"`
+		Open           string `toml:"open"`
+		AddReaction    string `toml:"add_reaction"`
+		ShowReactions  string `toml:"show_reactions"`
+		Forward        string `toml:"forward"`
+		PinMessage     string `toml:"pin_message"`
+		UnpinMessage   string `toml:"unpin_message"`
+		Jump           string `toml:"jump"`
+		OpenThread     string `toml:"open_thread"`
+		CreateThread   string `toml:"create_thread"`
+		RetrySend      string `toml:"retry_send"`
+		JumpToUnread   string `toml:"jump_to_unread"`
+		RevealSpoilers string `toml:"reveal_spoilers"`
+		VotePoll       string `toml:"vote_poll"`
+		Export         string `toml:"export"`
+
+		FocusComponents   string `toml:"focus_components"`
+		NextComponent     string `toml:"next_component"`
+		PreviousComponent string `toml:"previous_component"`
+		PressComponent    string `toml:"press_component"`
 
 		YankContent string `toml:"yank_content"`
 		YankURL     string `toml:"yank_url"`
@@ -71,8 +88,19 @@ type (
 		Cancel      string `toml:"cancel"`
 		TabComplete string `toml:"tab_complete"`
 
+		HistoryPrevious string `toml:"history_previous"`
+		HistoryNext     string `toml:"history_next"`
+
 		OpenEditor     string `toml:"open_editor"`
 		OpenFilePicker string `toml:"open_file_picker"`
+
+		Vim VimKeys `toml:"vim"`
+	}
+
+	VimKeys struct {
+		Enabled         bool   `toml:"enabled"`
+		SystemClipboard bool   `toml:"system_clipboard"`
+		NormalMode      string `toml:"normal_mode"`
 	}
 
 	MentionsListKeys struct {
@@ -85,6 +113,14 @@ type (
 		InitiateDM string `toml:"initiate_dm"`
 	}
 
+	SearchKeys struct {
+		NavigationKeys
+		NextPage     string `toml:"next_page"`
+		PreviousPage string `toml:"previous_page"`
+		EditQuery    string `toml:"edit_query"`
+		Cancel       string `toml:"cancel"`
+	}
+
 	FriendsListKeys struct {
 		NavigationKeys
 		InitiateDM string `toml:"initiate_dm"`
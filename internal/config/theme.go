@@ -134,14 +134,21 @@ type (
 	}
 
 	MessagesListTheme struct {
-		ReplyIndicator     string       `toml:"reply_indicator"`
-		ForwardedIndicator string       `toml:"forwarded_indicator"`
-		AuthorStyle        StyleWrapper `toml:"author_style"`
-		MentionStyle       StyleWrapper `toml:"mention_style"`
-		EmojiStyle         StyleWrapper `toml:"emoji_style"`
-		URLStyle           StyleWrapper `toml:"url_style"`
-		AttachmentStyle    StyleWrapper `toml:"attachment_style"`
-		ReactionStyle      StyleWrapper `toml:"reaction_style"`
+		ReplyIndicator      string       `toml:"reply_indicator"`
+		ForwardedIndicator  string       `toml:"forwarded_indicator"`
+		ThreadIndicator     string       `toml:"thread_indicator"`
+		BlockquoteIndicator string       `toml:"blockquote_indicator"`
+		AuthorStyle         StyleWrapper `toml:"author_style"`
+		MentionStyle        StyleWrapper `toml:"mention_style"`
+		EmojiStyle          StyleWrapper `toml:"emoji_style"`
+		URLStyle            StyleWrapper `toml:"url_style"`
+		AttachmentStyle     StyleWrapper `toml:"attachment_style"`
+		ReactionStyle       StyleWrapper `toml:"reaction_style"`
+		NewMessagesStyle    StyleWrapper `toml:"new_messages_style"`
+		BlockquoteStyle     StyleWrapper `toml:"blockquote_style"`
+		SpoilerStyle        StyleWrapper `toml:"spoiler_style"`
+		TimestampStyle      StyleWrapper `toml:"timestamp_style"`
+		PollStyle           StyleWrapper `toml:"poll_style"`
 	}
 
 	MentionsListTheme struct {
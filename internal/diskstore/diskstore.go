@@ -0,0 +1,480 @@
+// Package diskstore provides a store.Cabinet that keeps guilds, roles,
+// channels, members and messages in memory like defaultstore and persists them
+// to disk, so that they are available before the gateway is connected.
+package diskstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/gateway"
+	"github.com/diamondburned/arikawa/v3/state/store"
+	"github.com/diamondburned/arikawa/v3/state/store/defaultstore"
+)
+
+const (
+	meFile           = "me.json"
+	guildsFile       = "guilds.json"
+	guildFoldersFile = "guild_folders.json"
+	rolesFile        = "roles.json"
+	channelsFile     = "channels.json"
+	membersDir       = "members"
+	messagesDir      = "messages"
+
+	flushInterval = 30 * time.Second
+)
+
+type Options struct {
+	// MaxMessages is the number of messages kept per channel.
+	MaxMessages int
+	// MaxAge is the duration after which the members of a guild or the
+	// messages of a channel that have not been updated are evicted. Zero
+	// disables the eviction by age.
+	MaxAge time.Duration
+	// MaxSize is the total size in bytes of the persisted members and
+	// messages. The least recently updated files are evicted first once it is
+	// exceeded. Zero disables the eviction by size.
+	MaxSize int64
+}
+
+// Store persists the stores of its cabinet to a directory. Changes are written
+// periodically and when the store is closed.
+type Store struct {
+	dir     string
+	opts    Options
+	cabinet *store.Cabinet
+
+	mu    sync.Mutex
+	dirty struct {
+		me       bool
+		guilds   bool
+		roles    bool
+		channels bool
+		members  map[discord.GuildID]struct{}
+		messages map[discord.ChannelID]struct{}
+	}
+	persisted map[discord.ChannelID]struct{}
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates the directory if needed, evicts stale files, loads the
+// persisted state into a new cabinet and starts writing changes periodically.
+func New(dir string, opts Options) (*Store, error) {
+	for _, d := range []string{dir, filepath.Join(dir, membersDir), filepath.Join(dir, messagesDir)} {
+		if err := os.MkdirAll(d, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("failed to create store dir: %w", err)
+		}
+	}
+
+	s := &Store{
+		dir:  dir,
+		opts: opts,
+		done: make(chan struct{}),
+	}
+	s.dirty.members = make(map[discord.GuildID]struct{})
+	s.dirty.messages = make(map[discord.ChannelID]struct{})
+	s.persisted = make(map[discord.ChannelID]struct{})
+
+	s.evict()
+
+	s.cabinet = &store.Cabinet{
+		MeStore:         &meStore{MeStore: defaultstore.NewMe(), s: s},
+		ChannelStore:    &channelStore{ChannelStore: defaultstore.NewChannel(), s: s},
+		EmojiStore:      defaultstore.NewEmoji(),
+		GuildStore:      &guildStore{GuildStore: defaultstore.NewGuild(), s: s},
+		MemberStore:     &memberStore{MemberStore: defaultstore.NewMember(), s: s},
+		MessageStore:    &messageStore{MessageStore: defaultstore.NewMessage(opts.MaxMessages), s: s},
+		PresenceStore:   defaultstore.NewPresence(),
+		RoleStore:       &roleStore{RoleStore: defaultstore.NewRole(), s: s},
+		VoiceStateStore: defaultstore.NewVoiceState(),
+	}
+
+	s.load()
+
+	s.wg.Add(1)
+	go s.run()
+	return s, nil
+}
+
+// Cabinet returns the cabinet backed by the store.
+func (s *Store) Cabinet() *store.Cabinet {
+	return s.cabinet
+}
+
+// Close stops writing changes periodically and writes the pending ones.
+func (s *Store) Close() error {
+	close(s.done)
+	s.wg.Wait()
+	return s.Flush()
+}
+
+func (s *Store) run() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				slog.Error("failed to flush store", "err", err, "dir", s.dir)
+			}
+		}
+	}
+}
+
+// Flush writes the changes made since the last flush to disk. The changes that
+// fail to be written are kept to be written by the next flush.
+func (s *Store) Flush() error {
+	s.mu.Lock()
+	dirty := s.dirty
+	s.dirty.me, s.dirty.guilds, s.dirty.roles, s.dirty.channels = false, false, false, false
+	s.dirty.members = make(map[discord.GuildID]struct{})
+	s.dirty.messages = make(map[discord.ChannelID]struct{})
+	s.mu.Unlock()
+
+	var errs []error
+	// write writes the file and marks it dirty again if that fails.
+	write := func(name string, v any, mark func()) {
+		if err := s.write(name, v); err != nil {
+			errs = append(errs, err)
+			s.mu.Lock()
+			mark()
+			s.mu.Unlock()
+		}
+	}
+
+	if dirty.me {
+		if me, err := s.cabinet.Me(); err == nil {
+			write(meFile, me, func() { s.dirty.me = true })
+		}
+	}
+
+	// The guilds and channels are empty between a reset of the cabinet and
+	// READY; keep the persisted ones until they are set again.
+	if dirty.guilds {
+		if guilds, _ := s.cabinet.Guilds(); len(guilds) > 0 {
+			write(guildsFile, guilds, func() { s.dirty.guilds = true })
+		}
+	}
+
+	if dirty.roles {
+		if roles := s.roles(); len(roles) > 0 {
+			write(rolesFile, roles, func() { s.dirty.roles = true })
+		}
+	}
+
+	if dirty.channels {
+		if channels := s.channels(); len(channels) > 0 {
+			write(channelsFile, channels, func() { s.dirty.channels = true })
+		}
+	}
+
+	for guildID := range dirty.members {
+		members, _ := s.cabinet.Members(guildID)
+		write(filepath.Join(membersDir, guildID.String()+".json"), members, func() { s.dirty.members[guildID] = struct{}{} })
+	}
+
+	for channelID := range dirty.messages {
+		messages, _ := s.cabinet.Messages(channelID)
+		write(filepath.Join(messagesDir, channelID.String()+".json"), messages, func() { s.dirty.messages[channelID] = struct{}{} })
+	}
+
+	return errors.Join(errs...)
+}
+
+// channels returns the private channels and the channels of all guilds.
+func (s *Store) channels() []discord.Channel {
+	channels, _ := s.cabinet.PrivateChannels()
+
+	guilds, _ := s.cabinet.Guilds()
+	for _, g := range guilds {
+		guildChannels, _ := s.cabinet.Channels(g.ID)
+		channels = append(channels, guildChannels...)
+	}
+
+	return channels
+}
+
+// roles returns the roles of all guilds.
+func (s *Store) roles() map[discord.GuildID][]discord.Role {
+	roles := make(map[discord.GuildID][]discord.Role)
+
+	guilds, _ := s.cabinet.Guilds()
+	for _, g := range guilds {
+		if guildRoles, _ := s.cabinet.Roles(g.ID); len(guildRoles) > 0 {
+			roles[g.ID] = guildRoles
+		}
+	}
+
+	return roles
+}
+
+// write atomically replaces the file with the JSON encoding of v. Empty
+// slices remove the file instead.
+func (s *Store) write(name string, v any) error {
+	path := filepath.Join(s.dir, name)
+
+	switch v := v.(type) {
+	case []discord.Member:
+		if len(v) == 0 {
+			return removeIfExists(path)
+		}
+	case []discord.Message:
+		if len(v) == 0 {
+			return removeIfExists(path)
+		}
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := json.NewEncoder(f).Encode(v); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func removeIfExists(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Store) read(name string, v any) bool {
+	data, err := os.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			slog.Error("failed to read store file", "err", err, "name", name)
+		}
+
+		return false
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		slog.Error("failed to decode store file", "err", err, "name", name)
+		return false
+	}
+
+	return true
+}
+
+// load fills the cabinet with the persisted state without marking it dirty.
+func (s *Store) load() {
+	var me discord.User
+	if s.read(meFile, &me) {
+		s.cabinet.MeStore.(*meStore).MeStore.MyselfSet(me, false)
+	}
+
+	var guilds []discord.Guild
+	if s.read(guildsFile, &guilds) {
+		gs := s.cabinet.GuildStore.(*guildStore).GuildStore
+		for i := range guilds {
+			gs.GuildSet(&guilds[i], false)
+		}
+	}
+
+	var roles map[discord.GuildID][]discord.Role
+	if s.read(rolesFile, &roles) {
+		rs := s.cabinet.RoleStore.(*roleStore).RoleStore
+		for guildID, guildRoles := range roles {
+			for i := range guildRoles {
+				rs.RoleSet(guildID, &guildRoles[i], false)
+			}
+		}
+	}
+
+	var channels []discord.Channel
+	if s.read(channelsFile, &channels) {
+		cs := s.cabinet.ChannelStore.(*channelStore).ChannelStore
+		for i := range channels {
+			cs.ChannelSet(&channels[i], false)
+		}
+	}
+
+	s.loadMembers()
+	s.loadMessages()
+}
+
+func (s *Store) loadMembers() {
+	ms := s.cabinet.MemberStore.(*memberStore).MemberStore
+	s.walk(membersDir, func(id discord.Snowflake, name string) {
+		var members []discord.Member
+		if s.read(name, &members) {
+			for i := range members {
+				ms.MemberSet(discord.GuildID(id), &members[i], false)
+			}
+		}
+	})
+}
+
+func (s *Store) loadMessages() {
+	ms := s.cabinet.MessageStore.(*messageStore).MessageStore
+	s.walk(messagesDir, func(id discord.Snowflake, name string) {
+		var messages []discord.Message
+		if s.read(name, &messages) {
+			s.withLock(func() { s.persisted[discord.ChannelID(id)] = struct{}{} })
+
+			// Messages are persisted from latest to oldest; setting the oldest
+			// first keeps the order of the store.
+			for i := range slices.Backward(messages) {
+				ms.MessageSet(&messages[i], false)
+			}
+		}
+	})
+}
+
+// walk calls fn with the ID and the name relative to the store directory of
+// each file in the directory.
+func (s *Store) walk(dir string, fn func(id discord.Snowflake, name string)) {
+	entries, err := os.ReadDir(filepath.Join(s.dir, dir))
+	if err != nil {
+		slog.Error("failed to read store dir", "err", err, "dir", dir)
+		return
+	}
+
+	for _, e := range entries {
+		base, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok {
+			continue
+		}
+
+		id, err := discord.ParseSnowflake(base)
+		if err != nil {
+			continue
+		}
+
+		fn(id, filepath.Join(dir, e.Name()))
+	}
+}
+
+// evict removes the member and message files that were not updated within
+// MaxAge, and then the least recently updated ones until their total size is
+// within MaxSize.
+func (s *Store) evict() {
+	type file struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var files []file
+	for _, dir := range []string{membersDir, messagesDir} {
+		entries, err := os.ReadDir(filepath.Join(s.dir, dir))
+		if err != nil {
+			continue
+		}
+
+		for _, e := range entries {
+			info, err := e.Info()
+			if err != nil || info.IsDir() {
+				continue
+			}
+
+			files = append(files, file{filepath.Join(s.dir, dir, e.Name()), info.Size(), info.ModTime()})
+		}
+	}
+
+	// Latest first.
+	slices.SortFunc(files, func(a, b file) int {
+		return b.modTime.Compare(a.modTime)
+	})
+
+	var size int64
+	for _, f := range files {
+		// Temporary files are left behind by interrupted writes.
+		evict := strings.HasSuffix(f.path, ".tmp") || s.opts.MaxAge > 0 && time.Since(f.modTime) > s.opts.MaxAge
+		if !evict {
+			size += f.size
+			evict = s.opts.MaxSize > 0 && size > s.opts.MaxSize
+		}
+
+		if evict {
+			if err := os.Remove(f.path); err != nil {
+				slog.Error("failed to evict store file", "err", err, "path", f.path)
+			}
+		}
+	}
+}
+
+// withLock calls fn with the dirty and persisted sets locked.
+func (s *Store) withLock(fn func()) {
+	s.mu.Lock()
+	fn()
+	s.mu.Unlock()
+}
+
+// Persisted reports whether the messages of the channel were loaded from disk
+// and have not been replaced since. They lack the messages that were sent
+// while the store was not in use.
+func (s *Store) Persisted(channelID discord.ChannelID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.persisted[channelID]
+	return ok
+}
+
+// ReplaceMessages replaces the messages of the channel with the provided
+// ones, ordered from latest to oldest.
+func (s *Store) ReplaceMessages(channelID discord.ChannelID, messages []discord.Message) error {
+	ms := s.cabinet.MessageStore.(*messageStore).MessageStore
+
+	old, _ := ms.Messages(channelID)
+	for _, m := range old {
+		if err := ms.MessageRemove(channelID, m.ID); err != nil {
+			return err
+		}
+	}
+
+	for i := range slices.Backward(messages) {
+		if err := ms.MessageSet(&messages[i], false); err != nil {
+			return err
+		}
+	}
+
+	s.withLock(func() {
+		delete(s.persisted, channelID)
+		s.dirty.messages[channelID] = struct{}{}
+	})
+	return nil
+}
+
+// GuildFolders returns the guild folders of the user settings that were
+// persisted with SetGuildFolders.
+func (s *Store) GuildFolders() []gateway.GuildFolder {
+	var folders []gateway.GuildFolder
+	s.read(guildFoldersFile, &folders)
+	return folders
+}
+
+// SetGuildFolders persists the guild folders of the user settings, which are
+// not part of the cabinet.
+func (s *Store) SetGuildFolders(folders []gateway.GuildFolder) error {
+	return s.write(guildFoldersFile, folders)
+}
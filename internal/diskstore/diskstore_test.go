@@ -0,0 +1,242 @@
+package diskstore
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+const (
+	testGuildID   discord.GuildID   = 1
+	testChannelID discord.ChannelID = 2
+)
+
+func newTestStore(t *testing.T, dir string, opts Options) *Store {
+	t.Helper()
+
+	s, err := New(dir, opts)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return s
+}
+
+func TestFlushRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	s := newTestStore(t, dir, Options{MaxMessages: 50})
+	c := s.Cabinet()
+	c.MyselfSet(discord.User{ID: 3, Username: "me"}, false)
+	c.GuildSet(&discord.Guild{ID: testGuildID, Name: "guild"}, false)
+	c.RoleSet(testGuildID, &discord.Role{ID: 4, Name: "role"}, false)
+	c.ChannelSet(&discord.Channel{ID: testChannelID, GuildID: testGuildID, Name: "channel"}, false)
+	c.MemberSet(testGuildID, &discord.Member{User: discord.User{ID: 3}, Nick: "nick"}, false)
+	for _, id := range []discord.MessageID{5, 6, 7} {
+		c.MessageSet(&discord.Message{ID: id, ChannelID: testChannelID, Content: id.String()}, false)
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	s = newTestStore(t, dir, Options{MaxMessages: 50})
+	defer s.Close()
+	c = s.Cabinet()
+
+	tests := []struct {
+		name string
+		got  func() (string, error)
+		want string
+	}{
+		{"me", func() (string, error) {
+			me, err := c.Me()
+			if err != nil {
+				return "", err
+			}
+			return me.Username, nil
+		}, "me"},
+		{"guild", func() (string, error) {
+			g, err := c.Guild(testGuildID)
+			if err != nil {
+				return "", err
+			}
+			return g.Name, nil
+		}, "guild"},
+		{"role", func() (string, error) {
+			r, err := c.Role(testGuildID, 4)
+			if err != nil {
+				return "", err
+			}
+			return r.Name, nil
+		}, "role"},
+		{"channel", func() (string, error) {
+			ch, err := c.Channel(testChannelID)
+			if err != nil {
+				return "", err
+			}
+			return ch.Name, nil
+		}, "channel"},
+		{"member", func() (string, error) {
+			m, err := c.Member(testGuildID, 3)
+			if err != nil {
+				return "", err
+			}
+			return m.Nick, nil
+		}, "nick"},
+		{"messages", func() (string, error) {
+			messages, err := c.Messages(testChannelID)
+			var ids string
+			for _, m := range messages {
+				ids += m.Content
+			}
+			return ids, err
+		}, "765"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.got()
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if !s.Persisted(testChannelID) {
+		t.Errorf("Persisted(%d) = false, want true", testChannelID)
+	}
+}
+
+func TestFlushKeepsFailedWritesDirty(t *testing.T) {
+	dir := t.TempDir()
+	s := newTestStore(t, dir, Options{MaxMessages: 50})
+	defer s.Close()
+
+	// A file in place of the messages directory makes the write fail.
+	messages := filepath.Join(dir, messagesDir)
+	if err := os.Remove(messages); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(messages, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Cabinet().MessageSet(&discord.Message{ID: 5, ChannelID: testChannelID}, false)
+	if err := s.Flush(); err == nil {
+		t.Fatal("Flush() error = nil, want an error")
+	}
+
+	if err := os.Remove(messages); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(messages, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(messages, testChannelID.String()+".json")); err != nil {
+		t.Errorf("messages were not written after the failed flush: %v", err)
+	}
+}
+
+func TestEvict(t *testing.T) {
+	type file struct {
+		name string
+		size int
+		age  time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		opts  Options
+		files []file
+		want  []string
+	}{
+		{
+			name: "no limits",
+			files: []file{
+				{"members/1.json", 10, time.Hour},
+				{"messages/2.json", 10, 48 * time.Hour},
+			},
+			want: []string{"members/1.json", "messages/2.json"},
+		},
+		{
+			name: "temporary files",
+			files: []file{
+				{"messages/2.json", 10, time.Hour},
+				{"messages/2.json.123.tmp", 10, time.Hour},
+			},
+			want: []string{"messages/2.json"},
+		},
+		{
+			name: "max age",
+			opts: Options{MaxAge: 24 * time.Hour},
+			files: []file{
+				{"members/1.json", 10, time.Hour},
+				{"messages/2.json", 10, 48 * time.Hour},
+				{"messages/3.json", 10, 2 * time.Hour},
+			},
+			want: []string{"members/1.json", "messages/3.json"},
+		},
+		{
+			name: "max size keeps the latest",
+			opts: Options{MaxSize: 25},
+			files: []file{
+				{"members/1.json", 10, 3 * time.Hour},
+				{"messages/2.json", 10, time.Hour},
+				{"messages/3.json", 10, 2 * time.Hour},
+			},
+			want: []string{"messages/2.json", "messages/3.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, d := range []string{membersDir, messagesDir} {
+				if err := os.Mkdir(filepath.Join(dir, d), 0o755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			now := time.Now()
+			for _, f := range tt.files {
+				path := filepath.Join(dir, f.name)
+				if err := os.WriteFile(path, make([]byte, f.size), 0o644); err != nil {
+					t.Fatal(err)
+				}
+
+				modTime := now.Add(-f.age)
+				if err := os.Chtimes(path, modTime, modTime); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			s := &Store{dir: dir, opts: tt.opts}
+			s.evict()
+
+			var got []string
+			for _, d := range []string{membersDir, messagesDir} {
+				entries, _ := os.ReadDir(filepath.Join(dir, d))
+				for _, e := range entries {
+					got = append(got, d+"/"+e.Name())
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("files = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
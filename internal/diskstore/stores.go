@@ -0,0 +1,168 @@
+package diskstore
+
+import (
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/state/store"
+)
+
+// The stores below wrap the in-memory stores and mark what they change as
+// dirty so that it is written on the next flush.
+
+type meStore struct {
+	store.MeStore
+	s *Store
+}
+
+func (m *meStore) MyselfSet(me discord.User, update bool) error {
+	if err := m.MeStore.MyselfSet(me, update); err != nil {
+		return err
+	}
+
+	m.s.withLock(func() { m.s.dirty.me = true })
+	return nil
+}
+
+type guildStore struct {
+	store.GuildStore
+	s *Store
+}
+
+func (g *guildStore) GuildSet(guild *discord.Guild, update bool) error {
+	if err := g.GuildStore.GuildSet(guild, update); err != nil {
+		return err
+	}
+
+	g.s.withLock(func() { g.s.dirty.guilds = true })
+	return nil
+}
+
+func (g *guildStore) GuildRemove(id discord.GuildID) error {
+	if err := g.GuildStore.GuildRemove(id); err != nil {
+		return err
+	}
+
+	g.s.withLock(func() { g.s.dirty.guilds = true })
+	return nil
+}
+
+type roleStore struct {
+	store.RoleStore
+	s *Store
+}
+
+func (r *roleStore) RoleSet(guildID discord.GuildID, role *discord.Role, update bool) error {
+	if err := r.RoleStore.RoleSet(guildID, role, update); err != nil {
+		return err
+	}
+
+	r.s.withLock(func() { r.s.dirty.roles = true })
+	return nil
+}
+
+func (r *roleStore) RoleRemove(guildID discord.GuildID, roleID discord.RoleID) error {
+	if err := r.RoleStore.RoleRemove(guildID, roleID); err != nil {
+		return err
+	}
+
+	r.s.withLock(func() { r.s.dirty.roles = true })
+	return nil
+}
+
+type channelStore struct {
+	store.ChannelStore
+	s *Store
+}
+
+func (c *channelStore) ChannelSet(channel *discord.Channel, update bool) error {
+	if err := c.ChannelStore.ChannelSet(channel, update); err != nil {
+		return err
+	}
+
+	c.s.withLock(func() { c.s.dirty.channels = true })
+	return nil
+}
+
+func (c *channelStore) ChannelRemove(channel *discord.Channel) error {
+	if err := c.ChannelStore.ChannelRemove(channel); err != nil {
+		return err
+	}
+
+	c.s.withLock(func() { c.s.dirty.channels = true })
+	return nil
+}
+
+type memberStore struct {
+	store.MemberStore
+	s *Store
+}
+
+func (m *memberStore) MemberSet(guildID discord.GuildID, member *discord.Member, update bool) error {
+	if err := m.MemberStore.MemberSet(guildID, member, update); err != nil {
+		return err
+	}
+
+	m.s.withLock(func() { m.s.dirty.members[guildID] = struct{}{} })
+	return nil
+}
+
+func (m *memberStore) MemberRemove(guildID discord.GuildID, userID discord.UserID) error {
+	if err := m.MemberStore.MemberRemove(guildID, userID); err != nil {
+		return err
+	}
+
+	m.s.withLock(func() { m.s.dirty.members[guildID] = struct{}{} })
+	return nil
+}
+
+// Reset writes the pending changes and reloads the persisted members, since
+// only a part of them is sent again after READY.
+func (m *memberStore) Reset() error {
+	if err := m.s.Flush(); err != nil {
+		return err
+	}
+
+	if err := m.MemberStore.Reset(); err != nil {
+		return err
+	}
+
+	m.s.loadMembers()
+	return nil
+}
+
+type messageStore struct {
+	store.MessageStore
+	s *Store
+}
+
+func (m *messageStore) MessageSet(message *discord.Message, update bool) error {
+	if err := m.MessageStore.MessageSet(message, update); err != nil {
+		return err
+	}
+
+	m.s.withLock(func() { m.s.dirty.messages[message.ChannelID] = struct{}{} })
+	return nil
+}
+
+func (m *messageStore) MessageRemove(channelID discord.ChannelID, messageID discord.MessageID) error {
+	if err := m.MessageStore.MessageRemove(channelID, messageID); err != nil {
+		return err
+	}
+
+	m.s.withLock(func() { m.s.dirty.messages[channelID] = struct{}{} })
+	return nil
+}
+
+// Reset writes the pending changes and reloads the persisted messages, since
+// messages are not sent again after READY.
+func (m *messageStore) Reset() error {
+	if err := m.s.Flush(); err != nil {
+		return err
+	}
+
+	if err := m.MessageStore.Reset(); err != nil {
+		return err
+	}
+
+	m.s.loadMessages()
+	return nil
+}
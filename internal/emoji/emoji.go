@@ -0,0 +1,21 @@
+// Package emoji provides the set of Unicode emoji.
+package emoji
+
+//go:generate go run gen.go
+
+// Emoji is a fully-qualified Unicode emoji, which may be a sequence of code
+// points such as a flag or an emoji with a skin tone.
+type Emoji struct {
+	Emoji string
+	// Name is the CLDR short name, e.g. "grinning face".
+	Name string
+	// Group is the group that the emoji is listed under in keyboards, e.g.
+	// "Smileys & Emotion".
+	Group string
+}
+
+// All returns the Unicode emoji in the order that keyboards list them in. The
+// returned slice must not be modified.
+func All() []Emoji {
+	return all
+}
@@ -0,0 +1,105 @@
+//go:build ignore
+
+// gen generates the table of Unicode emoji from the emoji-test.txt file of
+// UTS #51.
+//
+//	go run gen.go [-src path or URL]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const defaultSrc = "https://unicode.org/Public/emoji/15.1/emoji-test.txt"
+
+func main() {
+	src := flag.String("src", defaultSrc, "path or URL of emoji-test.txt")
+	out := flag.String("out", "data.go", "output file")
+	flag.Parse()
+
+	r, err := open(*src)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\n")
+	b.WriteString("package emoji\n\n")
+	b.WriteString("var all = []Emoji{\n")
+
+	var group string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if g, ok := strings.CutPrefix(line, "# group: "); ok {
+			group = g
+			continue
+		}
+
+		// 1F600 ; fully-qualified # 😀 E1.0 grinning face
+		fields, comment, ok := strings.Cut(line, "#")
+		if !ok || !strings.Contains(fields, "; fully-qualified") {
+			continue
+		}
+
+		// Skip the emoji and its version.
+		parts := strings.SplitN(strings.TrimSpace(comment), " ", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		var e strings.Builder
+		for _, cp := range strings.Fields(strings.TrimSpace(strings.Split(fields, ";")[0])) {
+			r, err := strconv.ParseUint(cp, 16, 32)
+			if err != nil {
+				log.Fatalf("invalid code point %q: %v", cp, err)
+			}
+			e.WriteRune(rune(r))
+		}
+
+		fmt.Fprintf(&b, "{%q, %q, %q},\n", e.String(), parts[2], group)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	b.WriteString("}\n")
+
+	data, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func open(src string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return os.Open(src)
+	}
+
+	resp, err := http.Get(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return resp.Body, nil
+}
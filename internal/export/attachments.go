@@ -0,0 +1,98 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// filesSuffix is appended to the name of the output, without its extension,
+// for the directory that the attachments are downloaded to.
+const filesSuffix = "_files"
+
+// download downloads the attachments of the messages next to the output.
+// Attachments that were downloaded by a previous export are kept, and those
+// that fail to download are linked to instead.
+func (doc *document) download(ctx context.Context, client *http.Client, output string) error {
+	name := strings.TrimSuffix(filepath.Base(output), filepath.Ext(output)) + filesSuffix
+	dir := filepath.Join(filepath.Dir(output), name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create attachments dir: %w", err)
+	}
+
+	for _, m := range doc.Messages {
+		for _, a := range m.Attachments {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			file := a.ID.String() + "_" + sanitizeFilename(a.Filename)
+			path := filepath.Join(dir, file)
+			if info, err := os.Stat(path); err != nil || info.Size() != int64(a.Size) {
+				if err := downloadFile(ctx, client, string(a.URL), path); err != nil {
+					slog.Error("failed to download attachment", "err", err, "url", a.URL)
+					continue
+				}
+			}
+
+			doc.Files[a.ID] = filepath.ToSlash(filepath.Join(name, file))
+		}
+	}
+
+	return nil
+}
+
+func downloadFile(ctx context.Context, client *http.Client, url, path string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = io.Copy(f, resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// sanitizeFilename replaces the characters that are not allowed in the names
+// of files on common file systems.
+func sanitizeFilename(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < ' ' || strings.ContainsRune(`<>:"/\|?*`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+
+	if name == "" || name == "." || name == ".." {
+		return "file"
+	}
+
+	return name
+}
@@ -0,0 +1,316 @@
+// Package export writes the history of a channel to a file in one of several
+// formats.
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/api"
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+// pageSize is the number of messages fetched per request, the most that
+// Discord returns.
+const pageSize = 100
+
+// partSuffix is appended to the path of the output for the file that the
+// fetched messages are kept in until the export is complete. An interrupted
+// export resumes from it.
+const partSuffix = ".part"
+
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+	FormatText     Format = "text"
+)
+
+// ParseFormat returns the format with the name or one of its extensions.
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(name, ".")) {
+	case "json":
+		return FormatJSON, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "html", "htm":
+		return FormatHTML, nil
+	case "text", "txt":
+		return FormatText, nil
+	}
+
+	return "", fmt.Errorf("unknown export format %q", name)
+}
+
+// Ext returns the extension of the files of the format.
+func (f Format) Ext() string {
+	switch f {
+	case FormatJSON:
+		return ".json"
+	case FormatMarkdown:
+		return ".md"
+	case FormatHTML:
+		return ".html"
+	default:
+		return ".txt"
+	}
+}
+
+type Options struct {
+	Format Format
+	// Path is the path of the output file. The attachments are downloaded to
+	// a directory next to it.
+	Path string
+	// After and Before bound the time of the exported messages. Zero values
+	// do not bound it.
+	After, Before time.Time
+	// Authors are the IDs or names of the authors whose messages are
+	// exported. All messages are exported if it is empty.
+	Authors []string
+	// Attachments makes the attachments be downloaded with the HTTP client.
+	Attachments bool
+	// Progress, if not nil, is called after each page of messages is fetched
+	// with the number of messages fetched so far.
+	Progress func(fetched int)
+}
+
+// document is what is written to the output.
+type document struct {
+	Channel    discord.Channel
+	Guild      *discord.Guild
+	Messages   []discord.Message
+	ExportedAt time.Time
+	// Files maps the attachments that were downloaded to their paths
+	// relative to the output.
+	Files map[discord.AttachmentID]string
+	// dir is the directory of the output.
+	dir string
+}
+
+// partHeader is the first line of the part file. The part file is only
+// resumed from by an export of the same channel with the same time range, as
+// the range bounds the fetched messages.
+type partHeader struct {
+	ChannelID discord.ChannelID `json:"channel_id"`
+	After     time.Time         `json:"after"`
+	Before    time.Time         `json:"before"`
+}
+
+func (h partHeader) equal(other partHeader) bool {
+	return h.ChannelID == other.ChannelID && h.After.Equal(other.After) && h.Before.Equal(other.Before)
+}
+
+// Export fetches the history of the channel from oldest to newest with the
+// client, whose rate limiter paces the requests, and writes it to the output.
+// The requests are canceled with the context.
+// The fetched messages are kept in a part file until the output is written,
+// so that an interrupted export resumes where it stopped. It returns the
+// number of messages that were written.
+func Export(ctx context.Context, client *api.Client, files *http.Client, channelID discord.ChannelID, opts Options) (int, error) {
+	client = client.WithContext(ctx)
+	channel, err := client.Channel(channelID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	doc := document{
+		Channel:    *channel,
+		ExportedAt: time.Now(),
+		Files:      make(map[discord.AttachmentID]string),
+		dir:        filepath.Dir(opts.Path),
+	}
+	if channel.GuildID.IsValid() {
+		// The guild is only used for its name.
+		doc.Guild, _ = client.Guild(channel.GuildID)
+	}
+
+	partPath := opts.Path + partSuffix
+	doc.Messages, err = fetch(ctx, client, channelID, partPath, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	doc.Messages = slices.DeleteFunc(doc.Messages, func(m discord.Message) bool {
+		return !opts.matches(m)
+	})
+
+	if opts.Attachments {
+		if err := doc.download(ctx, files, opts.Path); err != nil {
+			return 0, err
+		}
+	}
+
+	var b bytes.Buffer
+	if err := render(&b, opts.Format, doc); err != nil {
+		return 0, fmt.Errorf("failed to render export: %w", err)
+	}
+
+	if err := writeFile(opts.Path, b.Bytes()); err != nil {
+		return 0, fmt.Errorf("failed to write export: %w", err)
+	}
+
+	if err := os.Remove(partPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, err
+	}
+
+	return len(doc.Messages), nil
+}
+
+// matches reports whether the message is within the time range and from one
+// of the authors.
+func (opts Options) matches(m discord.Message) bool {
+	t := m.ID.Time()
+	if !opts.After.IsZero() && t.Before(opts.After) || !opts.Before.IsZero() && !t.Before(opts.Before) {
+		return false
+	}
+
+	if len(opts.Authors) == 0 {
+		return true
+	}
+
+	for _, a := range opts.Authors {
+		if a == m.Author.ID.String() || strings.EqualFold(a, m.Author.Username) || strings.EqualFold(a, m.Author.DisplayName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fetch returns the messages of the channel from oldest to newest, resuming
+// from the part file if it is of the same export and appending the fetched
+// pages to it.
+func fetch(ctx context.Context, client *api.Client, channelID discord.ChannelID, partPath string, opts Options) ([]discord.Message, error) {
+	header := partHeader{ChannelID: channelID, After: opts.After, Before: opts.Before}
+	messages, offset, err := readPart(partPath, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var after discord.MessageID
+	if len(messages) > 0 {
+		after = messages[len(messages)-1].ID
+	} else if !opts.After.IsZero() {
+		after = discord.MessageID(discord.NewSnowflake(opts.After))
+	}
+
+	if err := os.MkdirAll(filepath.Dir(partPath), 0755); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(partPath, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// Drop what follows the last complete message, such as a message that
+	// was cut off by an interruption, or a part file of another export.
+	if err := f.Truncate(offset); err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	enc := json.NewEncoder(f)
+	if offset == 0 {
+		if err := enc.Encode(header); err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if opts.Progress != nil {
+			opts.Progress(len(messages))
+		}
+
+		// A single request, as the limit is the size of a page. The page is
+		// sorted from newest to oldest.
+		page, err := client.MessagesAfter(channelID, after, pageSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch messages: %w", err)
+		}
+		slices.Reverse(page)
+
+		for _, m := range page {
+			if err := enc.Encode(m); err != nil {
+				return nil, err
+			}
+		}
+		messages = append(messages, page...)
+
+		if len(page) < pageSize {
+			return messages, nil
+		}
+
+		after = page[len(page)-1].ID
+		if !opts.Before.IsZero() && !after.Time().Before(opts.Before) {
+			return messages, nil
+		}
+	}
+}
+
+// readPart returns the messages of the part file if its header is the given
+// one and the offset that follows the last complete one, or zero if the part
+// file cannot be resumed from.
+func readPart(path string, want partHeader) ([]discord.Message, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+	var header partHeader
+	if err := dec.Decode(&header); err != nil || !header.equal(want) {
+		return nil, 0, nil
+	}
+
+	var messages []discord.Message
+	offset := dec.InputOffset()
+	for {
+		var m discord.Message
+		if err := dec.Decode(&m); err != nil {
+			break
+		}
+		messages = append(messages, m)
+		offset = dec.InputOffset()
+	}
+
+	return messages, offset, nil
+}
+
+// writeFile atomically replaces the file with the data.
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,168 @@
+package export
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+func TestMatches(t *testing.T) {
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	message := func(t time.Time, username string) discord.Message {
+		return discord.Message{
+			ID:     discord.MessageID(discord.NewSnowflake(t)),
+			Author: discord.User{ID: 42, Username: username, DisplayName: "Display"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		opts    Options
+		message discord.Message
+		want    bool
+	}{
+		{"no filters", Options{}, message(day, "user"), true},
+		{"at after", Options{After: day}, message(day, "user"), true},
+		{"before after", Options{After: day}, message(day.Add(-time.Second), "user"), false},
+		{"at before", Options{Before: day}, message(day, "user"), false},
+		{"before before", Options{Before: day}, message(day.Add(-time.Second), "user"), true},
+		{"author by ID", Options{Authors: []string{"42"}}, message(day, "user"), true},
+		{"author by username", Options{Authors: []string{"USER"}}, message(day, "user"), true},
+		{"author by display name", Options{Authors: []string{"display"}}, message(day, "user"), true},
+		{"other author", Options{Authors: []string{"other"}}, message(day, "user"), false},
+		{"author out of range", Options{After: day, Authors: []string{"user"}}, message(day.Add(-time.Hour), "user"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opts.matches(tt.message); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadPart(t *testing.T) {
+	after := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	header := partHeader{ChannelID: 1, After: after}
+
+	encode := func(values ...any) string {
+		var s string
+		for _, v := range values {
+			b, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s += string(b) + "\n"
+		}
+		return s
+	}
+
+	complete := encode(header, discord.Message{ID: 10}, discord.Message{ID: 11})
+
+	tests := []struct {
+		name       string
+		content    string
+		want       []discord.MessageID
+		wantOffset int64
+	}{
+		{"empty", "", nil, 0},
+		{"header only", encode(header), nil, int64(len(encode(header))) - 1},
+		{"complete", complete, []discord.MessageID{10, 11}, int64(len(complete)) - 1},
+		{"cut off message", complete + `{"id":"12","con`, []discord.MessageID{10, 11}, int64(len(complete)) - 1},
+		{"other channel", encode(partHeader{ChannelID: 2, After: after}, discord.Message{ID: 10}), nil, 0},
+		{"other after", encode(partHeader{ChannelID: 1}, discord.Message{ID: 10}), nil, 0},
+		{"other before", encode(partHeader{ChannelID: 1, After: after, Before: after.Add(time.Hour)}, discord.Message{ID: 10}), nil, 0},
+		{"invalid header", "not json\n", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "export.md"+partSuffix)
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			messages, offset, err := readPart(path, header)
+			if err != nil {
+				t.Fatalf("readPart() error = %v", err)
+			}
+
+			var got []discord.MessageID
+			for _, m := range messages {
+				got = append(got, m.ID)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("messages = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("messages = %v, want %v", got, tt.want)
+				}
+			}
+
+			if offset != tt.wantOffset {
+				t.Errorf("offset = %d, want %d", offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestReadPartMissing(t *testing.T) {
+	messages, offset, err := readPart(filepath.Join(t.TempDir(), "missing"), partHeader{ChannelID: 1})
+	if err != nil || messages != nil || offset != 0 {
+		t.Errorf("readPart() = %v, %d, %v, want nil, 0, nil", messages, offset, err)
+	}
+}
+
+// TestResumeTruncation checks that a part file is truncated at the offset
+// returned by readPart, dropping a cut off message, and appended to like
+// fetch does when it resumes.
+func TestResumeTruncation(t *testing.T) {
+	header := partHeader{ChannelID: 1}
+	path := filepath.Join(t.TempDir(), "export.json"+partSuffix)
+
+	var content []byte
+	for _, v := range []any{header, discord.Message{ID: 10}} {
+		b, _ := json.Marshal(v)
+		content = append(append(content, b...), '\n')
+	}
+	content = append(content, `{"id":"11","cont`...)
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, offset, err := readPart(path, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Truncate(offset); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Seek(offset, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.NewEncoder(f).Encode(discord.Message{ID: 11}); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	messages, _, err := readPart(path, header)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 2 || messages[0].ID != 10 || messages[1].ID != 11 {
+		t.Errorf("messages after resuming = %v, want the messages 10 and 11", messages)
+	}
+}
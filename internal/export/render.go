@@ -0,0 +1,368 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/v3/discord"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	timeLayout     = "15:04"
+	dateTimeLayout = dateLayout + " " + timeLayout
+	// maxInlineImageSize bounds the images that are embedded in HTML exports.
+	// Larger ones are linked to.
+	maxInlineImageSize = 8 << 20
+)
+
+func render(w io.Writer, format Format, doc document) error {
+	switch format {
+	case FormatJSON:
+		return renderJSON(w, doc)
+	case FormatMarkdown:
+		return renderMarkdown(w, doc)
+	case FormatHTML:
+		return renderHTML(w, doc)
+	case FormatText:
+		return renderText(w, doc)
+	}
+
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+// title returns the name of the channel and of its guild, if any.
+func (doc document) title() string {
+	var name string
+	switch doc.Channel.Type {
+	case discord.DirectMessage, discord.GroupDM:
+		name = doc.Channel.Name
+		if name == "" {
+			recipients := make([]string, len(doc.Channel.DMRecipients))
+			for i, r := range doc.Channel.DMRecipients {
+				recipients[i] = r.DisplayOrUsername()
+			}
+			name = strings.Join(recipients, ", ")
+		}
+	default:
+		name = "#" + doc.Channel.Name
+	}
+
+	if doc.Guild != nil {
+		name += " (" + doc.Guild.Name + ")"
+	}
+
+	return name
+}
+
+// attachmentURL returns the path of the downloaded attachment, relative to
+// the output, or its URL if it was not downloaded.
+func (doc document) attachmentURL(a discord.Attachment) string {
+	if path, ok := doc.Files[a.ID]; ok {
+		return path
+	}
+	return string(a.URL)
+}
+
+// snapshot returns the content of the message that the message forwards, if
+// any.
+func snapshot(m discord.Message) (string, bool) {
+	if len(m.MessageSnapshots) == 0 {
+		return "", false
+	}
+	return m.MessageSnapshots[0].Message.Content, true
+}
+
+func isImage(a discord.Attachment) bool {
+	return strings.HasPrefix(a.ContentType, "image/")
+}
+
+func localTime(ts discord.Timestamp) time.Time {
+	return ts.Time().Local()
+}
+
+type jsonDocument struct {
+	Channel    discord.Channel                 `json:"channel"`
+	Guild      *discord.Guild                  `json:"guild,omitempty"`
+	ExportedAt time.Time                       `json:"exported_at"`
+	Files      map[discord.AttachmentID]string `json:"files,omitempty"`
+	Messages   []discord.Message               `json:"messages"`
+}
+
+func renderJSON(w io.Writer, doc document) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(jsonDocument{
+		Channel:    doc.Channel,
+		Guild:      doc.Guild,
+		ExportedAt: doc.ExportedAt,
+		Files:      doc.Files,
+		Messages:   doc.Messages,
+	})
+}
+
+func renderMarkdown(w io.Writer, doc document) error {
+	fmt.Fprintf(w, "# %s\n\n", doc.title())
+	fmt.Fprintf(w, "Exported on %s, %d messages.\n", doc.ExportedAt.Format(dateTimeLayout), len(doc.Messages))
+
+	var lastDate string
+	for _, m := range doc.Messages {
+		t := localTime(m.Timestamp)
+		if date := t.Format(dateLayout); date != lastDate {
+			fmt.Fprintf(w, "\n## %s\n", date)
+			lastDate = date
+		}
+
+		fmt.Fprintf(w, "\n**%s** %s", m.Author.DisplayOrUsername(), t.Format(timeLayout))
+		if m.EditedTimestamp.IsValid() {
+			io.WriteString(w, " (edited)")
+		}
+		io.WriteString(w, "\n\n")
+
+		if ref := m.ReferencedMessage; ref != nil {
+			fmt.Fprintf(w, "> Replying to **%s**: %s\n\n", ref.Author.DisplayOrUsername(), firstLine(ref.Content))
+		}
+		if content, ok := snapshot(m); ok {
+			fmt.Fprintf(w, "> *Forwarded*\n%s\n\n", quote(content))
+		}
+		if m.Content != "" {
+			fmt.Fprintf(w, "%s\n\n", m.Content)
+		}
+
+		for _, e := range m.Embeds {
+			if text := embedText(e); text != "" {
+				fmt.Fprintf(w, "%s\n\n", quote(text))
+			}
+		}
+
+		for _, a := range m.Attachments {
+			if isImage(a) {
+				fmt.Fprintf(w, "![%s](<%s>)\n\n", a.Filename, doc.attachmentURL(a))
+			} else {
+				fmt.Fprintf(w, "[%s](<%s>)\n\n", a.Filename, doc.attachmentURL(a))
+			}
+		}
+
+		if len(m.Reactions) > 0 {
+			fmt.Fprintf(w, "%s\n\n", reactionsText(m.Reactions))
+		}
+	}
+
+	return nil
+}
+
+func renderText(w io.Writer, doc document) error {
+	fmt.Fprintf(w, "%s\nExported on %s, %d messages.\n\n", doc.title(), doc.ExportedAt.Format(dateTimeLayout), len(doc.Messages))
+
+	for _, m := range doc.Messages {
+		if ref := m.ReferencedMessage; ref != nil {
+			fmt.Fprintf(w, "  ┌ %s: %s\n", ref.Author.DisplayOrUsername(), firstLine(ref.Content))
+		}
+
+		// The lines that follow the first one of the content are indented, so
+		// that each message starts at the beginning of a line.
+		fmt.Fprintf(w, "[%s] %s:", localTime(m.Timestamp).Format(dateTimeLayout), m.Author.DisplayOrUsername())
+		if content, ok := snapshot(m); ok {
+			fmt.Fprintf(w, " (forwarded) %s", strings.ReplaceAll(content, "\n", "\n  "))
+		}
+		if m.Content != "" {
+			fmt.Fprintf(w, " %s", strings.ReplaceAll(m.Content, "\n", "\n  "))
+		}
+		if m.EditedTimestamp.IsValid() {
+			io.WriteString(w, " (edited)")
+		}
+		io.WriteString(w, "\n")
+
+		for _, e := range m.Embeds {
+			if text := embedText(e); text != "" {
+				fmt.Fprintf(w, "  | %s\n", strings.ReplaceAll(text, "\n", "\n  | "))
+			}
+		}
+		for _, a := range m.Attachments {
+			fmt.Fprintf(w, "  Attachment: %s (%s)\n", a.Filename, doc.attachmentURL(a))
+		}
+		if len(m.Reactions) > 0 {
+			fmt.Fprintf(w, "  Reactions: %s\n", reactionsText(m.Reactions))
+		}
+	}
+
+	return nil
+}
+
+// htmlTemplate is the template of HTML exports, which are self-contained: the
+// styles are inline and the downloaded images are embedded.
+var htmlTemplate = template.Must(template.New("export").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { margin: 0; padding: 1em 2em; background: #313338; color: #dbdee1; font: 15px/1.4 sans-serif; }
+h1 { font-size: 1.4em; margin: 0 0 0.2em; }
+.info, .time, .reference, .date { color: #949ba4; font-size: 0.85em; }
+.date { border-top: 1px solid #3f4147; margin: 1.5em 0 0.5em; padding-top: 0.3em; }
+.message { margin: 0.8em 0; }
+.author { font-weight: bold; color: #f2f3f5; margin-right: 0.4em; }
+.content { white-space: pre-wrap; word-wrap: break-word; }
+.forwarded, .embed { border-left: 4px solid #4e5058; padding: 0.2em 0.6em; margin: 0.3em 0; white-space: pre-wrap; }
+.attachment img { display: block; max-width: 400px; max-height: 300px; margin: 0.3em 0; border-radius: 4px; }
+.reactions { color: #b5bac1; font-size: 0.9em; }
+a { color: #00a8fc; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="info">Exported on {{.ExportedAt}}, {{len .Messages}} messages.</div>
+{{range .Messages}}{{if .Date}}<div class="date">{{.Date}}</div>
+{{end}}<div class="message">
+{{with .Reply}}<div class="reference">Replying to {{.}}</div>{{end}}
+<span class="author">{{.Author}}</span><span class="time">{{.Time}}{{if .Edited}} (edited){{end}}</span>
+{{with .Forwarded}}<div class="forwarded">{{.}}</div>{{end}}
+{{with .Content}}<div class="content">{{.}}</div>{{end}}
+{{range .Embeds}}<div class="embed">{{.}}</div>{{end}}
+{{range .Attachments}}<div class="attachment">{{if .Src}}<a href="{{.URL}}"><img src="{{.Src}}" alt="{{.Name}}"></a>{{else}}<a href="{{.URL}}">{{.Name}}</a>{{end}}</div>{{end}}
+{{with .Reactions}}<div class="reactions">{{.}}</div>{{end}}
+</div>
+{{end}}
+</body>
+</html>
+`))
+
+type htmlMessage struct {
+	// Date is set for the first message of each day.
+	Date        string
+	Author      string
+	Time        string
+	Edited      bool
+	Reply       string
+	Forwarded   string
+	Content     string
+	Embeds      []string
+	Attachments []htmlAttachment
+	Reactions   string
+}
+
+type htmlAttachment struct {
+	Name string
+	URL  string
+	// Src is the data URL of images that are embedded.
+	Src template.URL
+}
+
+func renderHTML(w io.Writer, doc document) error {
+	data := struct {
+		Title      string
+		ExportedAt string
+		Messages   []htmlMessage
+	}{
+		Title:      doc.title(),
+		ExportedAt: doc.ExportedAt.Format(dateTimeLayout),
+	}
+
+	var lastDate string
+	for _, m := range doc.Messages {
+		t := localTime(m.Timestamp)
+		hm := htmlMessage{
+			Author:  m.Author.DisplayOrUsername(),
+			Time:    t.Format(timeLayout),
+			Edited:  m.EditedTimestamp.IsValid(),
+			Content: m.Content,
+		}
+
+		if date := t.Format(dateLayout); date != lastDate {
+			hm.Date = date
+			lastDate = date
+		}
+		if ref := m.ReferencedMessage; ref != nil {
+			hm.Reply = ref.Author.DisplayOrUsername() + ": " + firstLine(ref.Content)
+		}
+		hm.Forwarded, _ = snapshot(m)
+
+		for _, e := range m.Embeds {
+			if text := embedText(e); text != "" {
+				hm.Embeds = append(hm.Embeds, text)
+			}
+		}
+		for _, a := range m.Attachments {
+			hm.Attachments = append(hm.Attachments, doc.htmlAttachment(a))
+		}
+		if len(m.Reactions) > 0 {
+			hm.Reactions = reactionsText(m.Reactions)
+		}
+
+		data.Messages = append(data.Messages, hm)
+	}
+
+	return htmlTemplate.Execute(w, data)
+}
+
+// htmlAttachment embeds the attachment as a data URL if it is a downloaded
+// image.
+func (doc document) htmlAttachment(a discord.Attachment) htmlAttachment {
+	ha := htmlAttachment{Name: a.Filename, URL: doc.attachmentURL(a)}
+
+	path, ok := doc.Files[a.ID]
+	if !ok || !isImage(a) || a.Size > maxInlineImageSize {
+		return ha
+	}
+
+	data, err := os.ReadFile(filepath.Join(doc.dir, filepath.FromSlash(path)))
+	if err != nil {
+		return ha
+	}
+
+	ha.Src = template.URL("data:" + a.ContentType + ";base64," + base64.StdEncoding.EncodeToString(data))
+	return ha
+}
+
+// embedText returns the text of the embed.
+func embedText(e discord.Embed) string {
+	var parts []string
+	if e.Author != nil && e.Author.Name != "" {
+		parts = append(parts, e.Author.Name)
+	}
+	if e.Title != "" {
+		parts = append(parts, e.Title)
+	}
+	if e.Description != "" {
+		parts = append(parts, e.Description)
+	}
+	for _, f := range e.Fields {
+		parts = append(parts, f.Name+": "+f.Value)
+	}
+	if e.Footer != nil && e.Footer.Text != "" {
+		parts = append(parts, e.Footer.Text)
+	}
+
+	return strings.Join(parts, "\n")
+}
+
+func reactionsText(reactions []discord.Reaction) string {
+	parts := make([]string, len(reactions))
+	for i, r := range reactions {
+		name := r.Emoji.Name
+		if !r.Emoji.IsUnicode() {
+			name = ":" + name + ":"
+		}
+		parts[i] = fmt.Sprintf("%s %d", name, r.Count)
+	}
+
+	return strings.Join(parts, "  ")
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
+
+// quote prefixes the lines of the text with Markdown's quote marker.
+func quote(s string) string {
+	return "> " + strings.ReplaceAll(s, "\n", "\n> ")
+}
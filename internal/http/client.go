@@ -9,8 +9,14 @@ import (
 )
 
 func NewClient(token string) *api.Client {
+	return NewCustomClient(token, NewTransport())
+}
+
+// NewCustomClient is like NewClient, but sends the requests with the
+// transport.
+func NewCustomClient(token string, transport http.RoundTripper) *api.Client {
 	stdClient := http.DefaultClient
-	stdClient.Transport = NewTransport()
+	stdClient.Transport = transport
 	httpClient := httputil.NewClientWithDriver(httpdriver.WrapClient(*stdClient))
 	apiClient := api.NewCustomClient(token, httpClient)
 	apiClient.UserAgent = BrowserUserAgent
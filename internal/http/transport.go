@@ -1,15 +1,29 @@
 package http
 
 import (
+	"bytes"
 	"io"
+	"log/slog"
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/andybalholm/brotli"
 	"github.com/klauspost/compress/gzhttp"
 )
 
+// BodyFunc is called with the body of a successful JSON response to the
+// request. It must not keep the body after it returns.
+type BodyFunc func(req *http.Request, body []byte)
+
+type bodyHook struct {
+	path *regexp.Regexp
+	fn   BodyFunc
+}
+
 type Transport struct {
-	base http.RoundTripper
+	base   http.RoundTripper
+	onBody []bodyHook
 }
 
 func NewTransport() *Transport {
@@ -18,6 +32,14 @@ func NewTransport() *Transport {
 	}
 }
 
+// OnBody adds a function that is called with the body of the successful JSON
+// responses to the requests whose URL path matches the pattern, e.g. to read
+// fields that the API client does not decode. Only these responses are
+// buffered. It must be called before the transport is used.
+func (t *Transport) OnBody(path *regexp.Regexp, fn BodyFunc) {
+	t.onBody = append(t.onBody, bodyHook{path: path, fn: fn})
+}
+
 func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	resp, err := t.base.RoundTrip(req)
 	if err != nil {
@@ -32,5 +54,34 @@ func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		resp.Uncompressed = true
 	}
 
+	if resp.StatusCode/100 == 2 && strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		var fns []BodyFunc
+		for _, h := range t.onBody {
+			if h.path.MatchString(req.URL.Path) {
+				fns = append(fns, h.fn)
+			}
+		}
+
+		if len(fns) > 0 {
+			readBody(req, resp, fns)
+		}
+	}
+
 	return resp, nil
 }
+
+// readBody passes the body of the response to the body functions and replaces
+// it with a reader of the read body.
+func readBody(req *http.Request, resp *http.Response, fns []BodyFunc) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		slog.Error("failed to read response body", "err", err, "url", req.URL)
+		return
+	}
+
+	for _, fn := range fns {
+		fn(req, body)
+	}
+}
@@ -2,25 +2,56 @@
 package markdown
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/alecthomas/chroma/v2"
 	"github.com/alecthomas/chroma/v2/lexers"
 	"github.com/alecthomas/chroma/v2/styles"
 	"github.com/ayn2op/discordo/internal/config"
+	"github.com/ayn2op/tview"
 	"github.com/diamondburned/ningen/v3/discordmd"
 	"github.com/yuin/goldmark/ast"
 	gmr "github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
 )
 
+// timestampPattern matches the timestamp tags of Discord, <t:unix> and
+// <t:unix:style>.
+var timestampPattern = regexp.MustCompile(`<t:(-?\d{1,13})(?::([tTdDfFR]))?>`)
+
+// HasRelativeTimestamp reports whether the text has a relative timestamp tag,
+// <t:unix:R>, whose rendering changes as time passes.
+func HasRelativeTimestamp(text string) bool {
+	for _, m := range timestampPattern.FindAllStringSubmatch(text, -1) {
+		if m[2] == "R" {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Renderer struct {
 	theme config.MessagesListTheme
 
 	listIx     *int
 	listNested int
+
+	// revealSpoilers shows the content of spoilers instead of hiding it.
+	revealSpoilers bool
+	// subtext is set while a subtext (-#) line is rendered.
+	subtext bool
+	// emojiFunc returns the text that is drawn instead of the name of a custom
+	// emoji, if any.
+	emojiFunc func(emoji *discordmd.Emoji) (string, bool)
 }
 
 func NewRenderer(theme config.MessagesListTheme) *Renderer {
@@ -29,48 +60,101 @@ func NewRenderer(theme config.MessagesListTheme) *Renderer {
 
 func (r *Renderer) AddOptions(opts ...gmr.Option) {}
 
+// SetRevealSpoilers sets whether the following renders show the content of
+// spoilers.
+func (r *Renderer) SetRevealSpoilers(reveal bool) {
+	r.revealSpoilers = reveal
+}
+
+// SetEmojiFunc sets the function that returns the text that the following
+// renders draw instead of the names of custom emoji, e.g. their images. Nil
+// draws the names.
+func (r *Renderer) SetEmojiFunc(fn func(emoji *discordmd.Emoji) (string, bool)) {
+	r.emojiFunc = fn
+}
+
+// Render writes the node as text with tview style tags. The source is not
+// expected to be escaped; the text is escaped as it is written.
 func (r *Renderer) Render(w io.Writer, source []byte, node ast.Node) error {
+	qw := &quoteWriter{Writer: w, indicator: r.quoteIndicator(), lineStart: true}
+	r.subtext = false
+	r.listNested = 0
+	splitMaskedAutoLinks(node, source)
+
 	return ast.Walk(node, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
 		switch node := node.(type) {
 		case *ast.Document:
-		// noop
+			if !entering {
+				r.endSubtext(qw)
+			}
 		case *ast.Heading:
-			r.renderHeading(w, node, entering)
+			r.renderHeading(qw, node, entering)
+		case *ast.Paragraph:
+			if !entering {
+				r.endSubtext(qw)
+			}
+		case *ast.Blockquote:
+			r.renderBlockquote(qw, node, entering)
 		case *ast.Text:
-			r.renderText(w, node, entering, source)
+			r.renderText(qw, node, entering, source)
 		case *ast.FencedCodeBlock:
-			r.renderFencedCodeBlock(w, node, entering, source)
+			r.renderFencedCodeBlock(qw, node, entering, source)
 		case *ast.AutoLink:
-			r.renderAutoLink(w, node, entering, source)
+			r.renderAutoLink(qw, node, entering, source)
 		case *ast.Link:
-			r.renderLink(w, node, entering)
+			r.renderLink(qw, node, entering, source)
 		case *ast.List:
-			r.renderList(w, node, entering)
+			r.renderList(qw, node, entering)
 		case *ast.ListItem:
-			r.renderListItem(w, entering)
+			r.renderListItem(qw, entering)
 
 		case *discordmd.Inline:
-			r.renderInline(w, node, entering)
+			return r.renderInline(qw, node, entering, source), nil
 		case *discordmd.Mention:
-			r.renderMention(w, node, entering)
+			r.renderMention(qw, node, entering)
 		case *discordmd.Emoji:
-			r.renderEmoji(w, node, entering)
+			r.renderEmoji(qw, node, entering)
 		}
 
 		return ast.WalkContinue, nil
 	})
 }
 
-func (r *Renderer) renderHeading(w io.Writer, node *ast.Heading, entering bool) {
+func (r *Renderer) renderHeading(w *quoteWriter, node *ast.Heading, entering bool) {
 	if entering {
+		if node.PreviousSibling() != nil && !w.lineStart {
+			io.WriteString(w, "\n")
+		}
+
+		io.WriteString(w, "[::b]")
 		io.WriteString(w, strings.Repeat("#", node.Level))
 		io.WriteString(w, " ")
 	} else {
-		io.WriteString(w, "\n")
+		io.WriteString(w, "[::B]\n")
+	}
+}
+
+// renderBlockquote quotes the lines of the block quote. Each line of a block
+// quote is parsed as a paragraph of its own.
+func (r *Renderer) renderBlockquote(w *quoteWriter, node *ast.Blockquote, entering bool) {
+	if entering {
+		// The previous paragraph does not end with a line break.
+		if node.PreviousSibling() != nil && !w.lineStart {
+			io.WriteString(w, "\n")
+		}
+		w.quoted = true
+	} else {
+		w.quoted = false
 	}
 }
 
-func (r *Renderer) renderFencedCodeBlock(w io.Writer, node *ast.FencedCodeBlock, entering bool, source []byte) {
+func (r *Renderer) quoteIndicator() string {
+	fg := r.theme.BlockquoteStyle.GetForeground()
+	bg := r.theme.BlockquoteStyle.GetBackground()
+	return fmt.Sprintf("[%s:%s]%s[-:-] ", fg, bg, tview.Escape(r.theme.BlockquoteIndicator))
+}
+
+func (r *Renderer) renderFencedCodeBlock(w *quoteWriter, node *ast.FencedCodeBlock, entering bool, source []byte) {
 	io.WriteString(w, "\n")
 
 	if entering {
@@ -84,7 +168,7 @@ func (r *Renderer) renderFencedCodeBlock(w io.Writer, node *ast.FencedCodeBlock,
 		io.WriteString(w, "[::d]╭─")
 		if langName != "" {
 			io.WriteString(w, "[ [::b]")
-			io.WriteString(w, langName)
+			io.WriteString(w, tview.Escape(langName))
 			io.WriteString(w, "[::B] ][::D]")
 		} else {
 			io.WriteString(w, "──")
@@ -134,7 +218,7 @@ func (r *Renderer) renderCodeWithoutHighlight(w io.Writer, code string) {
 	lines := strings.Split(code, "\n")
 	for _, line := range lines {
 		io.WriteString(w, "[::d]│[::D] ")
-		io.WriteString(w, line)
+		io.WriteString(w, tview.Escape(line))
 		if line != "" {
 			io.WriteString(w, "\n")
 		}
@@ -146,7 +230,7 @@ func (r *Renderer) renderHighlightedCode(w io.Writer, iterator chroma.Iterator,
 
 	for token := iterator(); token != chroma.EOF; token = iterator() {
 		color := r.tokenTypeToColor(token.Type, style)
-		value := token.Value
+		value := tview.Escape(token.Value)
 
 		// Split by newlines to handle multi-line tokens
 		lines := strings.Split(value, "\n")
@@ -221,20 +305,77 @@ func (r *Renderer) tokenTypeToColor(tokenType chroma.TokenType, style *chroma.St
 	}
 }
 
-func (r *Renderer) renderAutoLink(w io.Writer, node *ast.AutoLink, entering bool, source []byte) {
+func (r *Renderer) renderAutoLink(w *quoteWriter, node *ast.AutoLink, entering bool, source []byte) {
 	urlStyle := r.theme.URLStyle
 
 	if entering {
 		fg := urlStyle.GetForeground()
 		bg := urlStyle.GetBackground()
 		fmt.Fprintf(w, "[%s:%s]", fg, bg)
-		w.Write(node.URL(source))
+		io.WriteString(w, tview.Escape(string(node.URL(source))))
 	} else {
 		io.WriteString(w, "[-:-]")
 	}
 }
 
-func (r *Renderer) renderLink(w io.Writer, node *ast.Link, entering bool) {
+// splitMaskedAutoLinks turns the masked links whose text is a URL, such as
+// [https://a.com](https://b.com), back into links. The autolink parser of
+// discordmd runs before the link parser, so such a link is parsed as a "["
+// followed by an autolink that runs up to the next space.
+func splitMaskedAutoLinks(node ast.Node, source []byte) {
+	var autoLinks []*ast.AutoLink
+	ast.Walk(node, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if l, ok := node.(*ast.AutoLink); ok && entering {
+			autoLinks = append(autoLinks, l)
+		}
+		return ast.WalkContinue, nil
+	})
+
+	for _, l := range autoLinks {
+		splitMaskedAutoLink(l, source)
+	}
+}
+
+func splitMaskedAutoLink(l *ast.AutoLink, source []byte) {
+	prev, ok := l.PreviousSibling().(*ast.Text)
+	if !ok || prev.Segment.Len() == 0 || source[prev.Segment.Stop-1] != '[' {
+		return
+	}
+
+	// The autolink starts right after the opener.
+	start := prev.Segment.Stop
+	value := l.URL(source)
+	if !bytes.HasPrefix(source[start:], value) {
+		return
+	}
+
+	textEnd := bytes.Index(value, []byte("]("))
+	if textEnd == -1 {
+		return
+	}
+	destEnd := bytes.IndexByte(value[textEnd+2:], ')')
+	if destEnd == -1 {
+		return
+	}
+	destEnd += textEnd + 2
+
+	link := ast.NewLink()
+	link.Destination = value[textEnd+2 : destEnd]
+	link.AppendChild(link, ast.NewTextSegment(text.NewSegment(start, start+textEnd)))
+
+	parent := l.Parent()
+	parent.ReplaceChild(parent, l, link)
+	if rest := text.NewSegment(start+destEnd+1, start+len(value)); rest.Len() > 0 {
+		parent.InsertAfter(parent, link, ast.NewTextSegment(rest))
+	}
+
+	prev.Segment = prev.Segment.WithStop(prev.Segment.Stop - 1)
+	if prev.Segment.Len() == 0 {
+		parent.RemoveChild(parent, prev)
+	}
+}
+
+func (r *Renderer) renderLink(w io.Writer, node *ast.Link, entering bool, source []byte) {
 	urlStyle := r.theme.URLStyle
 	if entering {
 		fg := urlStyle.GetForeground()
@@ -242,6 +383,18 @@ func (r *Renderer) renderLink(w io.Writer, node *ast.Link, entering bool) {
 		fmt.Fprintf(w, "[%s:%s::%s]", fg, bg, node.Destination)
 	} else {
 		io.WriteString(w, "[-:-::-]")
+
+		// The text of a masked link can pretend to be another URL, so the
+		// host that it actually leads to is shown after it.
+		dest := string(node.Destination)
+		host := dest
+		if u, err := url.Parse(dest); err == nil && u.Host != "" {
+			host = u.Host
+		}
+
+		if text := string(node.Text(source)); text != dest && text != host {
+			fmt.Fprintf(w, " [::d](%s)[::D]", tview.Escape(host))
+		}
 	}
 }
 
@@ -276,23 +429,124 @@ func (r *Renderer) renderListItem(w io.Writer, entering bool) {
 	}
 }
 
-func (r *Renderer) renderText(w io.Writer, node *ast.Text, entering bool, source []byte) {
-	if entering {
-		w.Write(node.Segment.Value(source))
-		switch {
-		case node.HardLineBreak():
-			io.WriteString(w, "\n\n")
-		case node.SoftLineBreak():
-			io.WriteString(w, "\n")
+func (r *Renderer) renderText(w *quoteWriter, node *ast.Text, entering bool, source []byte) {
+	if !entering {
+		return
+	}
+
+	// Adjacent text nodes are written together, so that a style tag cannot
+	// be formed from the escaped parts of the text.
+	if prev, ok := node.PreviousSibling().(*ast.Text); ok && !hasLineBreak(prev) {
+		return
+	}
+
+	value := node.Segment.Value(source)
+	last := node
+	for !hasLineBreak(last) {
+		next, ok := last.NextSibling().(*ast.Text)
+		if !ok {
+			break
+		}
+
+		value = append(value[:len(value):len(value)], next.Segment.Value(source)...)
+		last = next
+	}
+
+	if isLineStart(node) {
+		// A multi-line block quote quotes the rest of the message.
+		if rest, ok := bytes.CutPrefix(value, []byte(">>> ")); ok {
+			w.quoted = true
+			value = rest
+		}
+
+		if rest, ok := bytes.CutPrefix(value, []byte("-# ")); ok {
+			io.WriteString(w, "[::d]")
+			r.subtext = true
+			value = rest
 		}
 	}
+
+	r.writeText(w, value)
+
+	switch {
+	case last.HardLineBreak():
+		r.endSubtext(w)
+		io.WriteString(w, "\n\n")
+	case last.SoftLineBreak():
+		r.endSubtext(w)
+		io.WriteString(w, "\n")
+	}
+}
+
+// writeText writes the escaped text with its timestamp tags formatted.
+func (r *Renderer) writeText(w io.Writer, text []byte) {
+	for len(text) > 0 {
+		loc := timestampPattern.FindSubmatchIndex(text)
+		if loc == nil {
+			io.WriteString(w, tview.Escape(string(text)))
+			return
+		}
+
+		io.WriteString(w, tview.Escape(string(text[:loc[0]])))
+
+		unix, _ := strconv.ParseInt(string(text[loc[2]:loc[3]]), 10, 64)
+		var style byte = 'f'
+		if loc[4] != -1 {
+			style = text[loc[4]]
+		}
+
+		fg := r.theme.TimestampStyle.GetForeground()
+		bg := r.theme.TimestampStyle.GetBackground()
+		fmt.Fprintf(w, "[%s:%s]%s[-:-]", fg, bg, formatTimestamp(time.Unix(unix, 0), style))
+
+		text = text[loc[1]:]
+	}
+}
+
+func (r *Renderer) endSubtext(w io.Writer) {
+	if r.subtext {
+		io.WriteString(w, "[::D]")
+		r.subtext = false
+	}
 }
-func (r *Renderer) renderInline(w io.Writer, node *discordmd.Inline, entering bool) {
-	if start, end := attrToTag(node.Attr); entering && start != "" {
+
+// isLineStart reports whether the text starts a line of its block.
+func isLineStart(node ast.Node) bool {
+	prev := node.PreviousSibling()
+	if prev == nil {
+		_, ok := node.Parent().(*ast.Paragraph)
+		return ok
+	}
+
+	text, ok := prev.(*ast.Text)
+	return ok && hasLineBreak(text)
+}
+
+func hasLineBreak(node *ast.Text) bool {
+	return node.SoftLineBreak() || node.HardLineBreak()
+}
+
+func (r *Renderer) renderInline(w io.Writer, node *discordmd.Inline, entering bool, source []byte) ast.WalkStatus {
+	if node.Attr&discordmd.AttrSpoiler != 0 && !r.revealSpoilers {
+		// Hide the content, but keep its length.
+		if entering {
+			fg := r.theme.SpoilerStyle.GetForeground()
+			bg := r.theme.SpoilerStyle.GetBackground()
+			n := max(utf8.RuneCount(node.Text(source)), 1)
+			fmt.Fprintf(w, "[%s:%s]%s[-:-]", fg, bg, strings.Repeat("█", n))
+		}
+
+		return ast.WalkSkipChildren
+	}
+
+	start, end := attrToTag(node.Attr)
+	if entering {
 		io.WriteString(w, start)
 	} else {
 		io.WriteString(w, end)
 	}
+
+	return ast.WalkContinue
 }
 
 func (r *Renderer) renderMention(w io.Writer, node *discordmd.Mention, entering bool) {
@@ -304,16 +558,16 @@ func (r *Renderer) renderMention(w io.Writer, node *discordmd.Mention, entering
 
 		switch {
 		case node.Channel != nil:
-			io.WriteString(w, "#"+node.Channel.Name)
+			io.WriteString(w, "#"+tview.Escape(node.Channel.Name))
 		case node.GuildUser != nil:
 			name := node.GuildUser.DisplayOrUsername()
 			if member := node.GuildUser.Member; member != nil && member.Nick != "" {
 				name = member.Nick
 			}
 
-			io.WriteString(w, "@"+name)
+			io.WriteString(w, "@"+tview.Escape(name))
 		case node.GuildRole != nil:
-			io.WriteString(w, "@"+node.GuildRole.Name)
+			io.WriteString(w, "@"+tview.Escape(node.GuildRole.Name))
 		}
 	} else {
 		io.WriteString(w, "[-:-:B]")
@@ -326,25 +580,140 @@ func (r *Renderer) renderEmoji(w io.Writer, node *discordmd.Emoji, entering bool
 		fg := emojiStyle.GetForeground()
 		bg := emojiStyle.GetBackground()
 		fmt.Fprintf(w, "[%s:%s]", fg, bg)
+		if r.emojiFunc != nil {
+			if s, ok := r.emojiFunc(node); ok {
+				io.WriteString(w, s)
+				return
+			}
+		}
 		io.WriteString(w, ":"+node.Name+":")
 	} else {
 		io.WriteString(w, "[-:-]")
 	}
 }
 
+// attrToTag returns the tags that start and end the attributes. Revealed
+// spoilers are dimmed to set them apart from the rest of the text.
 func attrToTag(attr discordmd.Attribute) (string, string) {
-	switch attr {
-	case discordmd.AttrBold:
-		return "[::b]", "[::B]"
-	case discordmd.AttrItalics:
-		return "[::i]", "[::I]"
-	case discordmd.AttrUnderline:
-		return "[::u]", "[::U]"
-	case discordmd.AttrStrikethrough:
-		return "[::s]", "[::S]"
-	case discordmd.AttrMonospace:
-		return "[::r]", "[::R]"
-	default:
+	var start, end string
+	for _, a := range []struct {
+		attr       discordmd.Attribute
+		start, end string
+	}{
+		{discordmd.AttrBold, "b", "B"},
+		{discordmd.AttrItalics, "i", "I"},
+		{discordmd.AttrUnderline, "u", "U"},
+		{discordmd.AttrStrikethrough, "s", "S"},
+		{discordmd.AttrMonospace, "r", "R"},
+		{discordmd.AttrSpoiler, "d", "D"},
+	} {
+		if attr&a.attr != 0 {
+			start += a.start
+			end += a.end
+		}
+	}
+
+	if start == "" {
 		return "", ""
 	}
+
+	return "[::" + start + "]", "[::" + end + "]"
+}
+
+// formatTimestamp formats the time of a timestamp tag in local time according
+// to its style.
+func formatTimestamp(t time.Time, style byte) string {
+	t = t.Local()
+	switch style {
+	case 't':
+		return t.Format("3:04 PM")
+	case 'T':
+		return t.Format("3:04:05 PM")
+	case 'd':
+		return t.Format("01/02/2006")
+	case 'D':
+		return t.Format("January 2, 2006")
+	case 'F':
+		return t.Format("Monday, January 2, 2006 3:04 PM")
+	case 'R':
+		return relativeTime(t)
+	default:
+		return t.Format("January 2, 2006 3:04 PM")
+	}
+}
+
+// relativeTime formats the time relative to now, e.g. "in 5 minutes" or
+// "2 days ago".
+func relativeTime(t time.Time) string {
+	d := time.Until(t)
+	future := d > 0
+	if !future {
+		d = -d
+	}
+
+	var (
+		n    int64
+		unit string
+	)
+	switch {
+	case d < time.Minute:
+		n, unit = int64(d/time.Second), "second"
+	case d < time.Hour:
+		n, unit = int64(d/time.Minute), "minute"
+	case d < 24*time.Hour:
+		n, unit = int64(d/time.Hour), "hour"
+	case d < 30*24*time.Hour:
+		n, unit = int64(d/(24*time.Hour)), "day"
+	case d < 365*24*time.Hour:
+		n, unit = int64(d/(30*24*time.Hour)), "month"
+	default:
+		n, unit = int64(d/(365*24*time.Hour)), "year"
+	}
+
+	s := strconv.FormatInt(n, 10) + " " + unit
+	if n != 1 {
+		s += "s"
+	}
+
+	if future {
+		return "in " + s
+	}
+	return s + " ago"
+}
+
+// quoteWriter writes the indicator of block quotes at the start of each line
+// while quoted is set.
+type quoteWriter struct {
+	io.Writer
+	indicator string
+	quoted    bool
+	lineStart bool
+}
+
+func (qw *quoteWriter) Write(p []byte) (int, error) {
+	var n int
+	for len(p) > 0 {
+		if qw.quoted && qw.lineStart {
+			if _, err := io.WriteString(qw.Writer, qw.indicator); err != nil {
+				return n, err
+			}
+		}
+
+		line := p
+		i := bytes.IndexByte(p, '\n')
+		if i != -1 {
+			line = p[:i+1]
+		}
+
+		m, err := qw.Writer.Write(line)
+		n += m
+		if err != nil {
+			return n, err
+		}
+
+		qw.lineStart = i != -1
+		p = p[len(line):]
+	}
+
+	return n, nil
 }
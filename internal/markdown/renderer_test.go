@@ -0,0 +1,104 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ayn2op/discordo/internal/config"
+	"github.com/diamondburned/arikawa/v3/discord"
+	"github.com/diamondburned/arikawa/v3/state/store"
+	"github.com/diamondburned/ningen/v3/discordmd"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+// testQuote is the quote indicator that the test renderer writes.
+const testQuote = "[default:default]▎[-:-] "
+
+func newTestRenderer() *Renderer {
+	return NewRenderer(config.MessagesListTheme{BlockquoteIndicator: "▎"})
+}
+
+func render(t *testing.T, src []byte, node ast.Node) string {
+	t.Helper()
+
+	var b strings.Builder
+	if err := newTestRenderer().Render(&b, src, node); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	return b.String()
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "quote starting with URL",
+			src:  "> https://example.com is cool\n> second",
+			want: testQuote + "[default:default]https://example.com[-:-] is cool\n" + testQuote + "second",
+		},
+		{
+			name: "masked link",
+			src:  "[text](https://evil.com/x)",
+			want: "[default:default::https://evil.com/x]text[-:-::-] [::d](evil.com)[::D]",
+		},
+		{
+			name: "masked link with URL text",
+			src:  "[https://google.com](https://evil.com/x)",
+			want: "[default:default::https://evil.com/x]https://google.com[-:-::-] [::d](evil.com)[::D]",
+		},
+		{
+			name: "masked link with URL text in sentence",
+			src:  "see [https://google.com](https://evil.com/x). ok",
+			want: "see [default:default::https://evil.com/x]https://google.com[-:-::-] [::d](evil.com)[::D]. ok",
+		},
+		{
+			name: "masked link with its destination as text",
+			src:  "[https://example.com](https://example.com)",
+			want: "[default:default::https://example.com]https://example.com[-:-::-]",
+		},
+		{
+			name: "URL",
+			src:  "https://example.com",
+			want: "[default:default]https://example.com[-:-]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := []byte(tt.src)
+			node := discordmd.ParseWithMessage(src, *store.NoopCabinet, &discord.Message{}, false)
+			if got := render(t, src, node); got != tt.want {
+				t.Errorf("Render(%q) =\n%q\nwant\n%q", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRenderQuotedCodeBlock renders a block quote that contains a code block,
+// which discordmd does not parse but other parsers do.
+func TestRenderQuotedCodeBlock(t *testing.T) {
+	src := []byte("x := 1\n")
+
+	code := ast.NewFencedCodeBlock(nil)
+	code.Lines().Append(text.NewSegment(0, len(src)))
+
+	quote := ast.NewBlockquote()
+	quote.AppendChild(quote, code)
+
+	doc := ast.NewDocument()
+	doc.AppendChild(doc, quote)
+
+	got := render(t, src, doc)
+	for i, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		if !strings.HasPrefix(line, testQuote) {
+			t.Errorf("line %d = %q, want it quoted", i, line)
+		}
+		if strings.Count(line, "▎") != 1 {
+			t.Errorf("line %d = %q, want one quote indicator", i, line)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+// Package media provides a disk cache of downloaded media, such as avatars,
+// attachments and images, that is shared by the features that download them.
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// filesDir holds the downloaded files, named after the SHA-256 of their
+	// content, so that the same content downloaded from different URLs is
+	// stored once.
+	filesDir = "files"
+	// refsDir holds a file for each downloaded URL, named after the SHA-256 of
+	// the URL, that contains the name of its file in filesDir.
+	refsDir = "refs"
+	// maxExtLength bounds the extensions that are kept in the names of the
+	// files, which are needed to open them with the right program.
+	maxExtLength = 8
+)
+
+// signedParams are the query parameters of the signed URLs of Discord's CDN,
+// which change while the file stays the same.
+var signedParams = []string{"ex", "is", "hm"}
+
+type Options struct {
+	// MaxAge is the duration after which files that have not been used are
+	// evicted. Zero disables the eviction by age.
+	MaxAge time.Duration
+	// MaxSize is the total size in bytes of the files. The least recently
+	// used files are evicted first once it is exceeded. Zero disables the
+	// eviction by size.
+	MaxSize int64
+}
+
+// Cache downloads files to a directory and evicts the least recently used
+// ones. Concurrent requests for the same URL share a single download.
+type Cache struct {
+	dir    string
+	client *http.Client
+	opts   Options
+
+	mu       sync.Mutex
+	size     int64
+	evicting bool
+	inflight map[string]*download
+}
+
+type download struct {
+	done chan struct{}
+	path string
+	err  error
+}
+
+// New creates the directory if needed and evicts stale files in the
+// background. The files are downloaded with the client.
+func New(dir string, client *http.Client, opts Options) (*Cache, error) {
+	for _, d := range []string{filepath.Join(dir, filesDir), filepath.Join(dir, refsDir)} {
+		if err := os.MkdirAll(d, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("failed to create media cache dir: %w", err)
+		}
+	}
+
+	c := &Cache{
+		dir:      dir,
+		client:   client,
+		opts:     opts,
+		evicting: true,
+		inflight: make(map[string]*download),
+	}
+
+	go c.evict()
+	return c, nil
+}
+
+// Get returns the path of the file downloaded from the URL, downloading it
+// first if it is not cached.
+func (c *Cache) Get(ctx context.Context, rawURL string) (string, error) {
+	key := Key(rawURL)
+	if path, ok := c.lookup(key); ok {
+		return path, nil
+	}
+
+	c.mu.Lock()
+	if d, ok := c.inflight[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-d.done:
+			return d.path, d.err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+
+	d := &download{done: make(chan struct{})}
+	c.inflight[key] = d
+	c.mu.Unlock()
+
+	d.path, d.err = c.download(ctx, key, rawURL)
+
+	c.mu.Lock()
+	delete(c.inflight, key)
+	c.mu.Unlock()
+	close(d.done)
+
+	return d.path, d.err
+}
+
+// Key returns the key of the URL in the cache. The query parameters that sign
+// URLs of Discord's CDN are ignored, so that a file is not downloaded again
+// once its URL is signed again.
+func Key(rawURL string) string {
+	if u, err := url.Parse(rawURL); err == nil {
+		q := u.Query()
+		for _, p := range signedParams {
+			q.Del(p)
+		}
+		u.RawQuery = q.Encode()
+		u.Fragment = ""
+		rawURL = u.String()
+	}
+
+	sum := sha256.Sum256([]byte(rawURL))
+	return hex.EncodeToString(sum[:])
+}
+
+// lookup returns the path of the cached file of the key and marks it as
+// recently used.
+func (c *Cache) lookup(key string) (string, bool) {
+	name, err := os.ReadFile(filepath.Join(c.dir, refsDir, key))
+	if err != nil {
+		return "", false
+	}
+
+	path := filepath.Join(c.dir, filesDir, filepath.Base(string(name)))
+	now := time.Now()
+	// The file may have been evicted.
+	if err := os.Chtimes(path, now, now); err != nil {
+		return "", false
+	}
+
+	return path, true
+}
+
+// download downloads the URL to a temporary file, which is moved to the path
+// of its content once it is complete so that a failed download does not leave
+// a broken file behind.
+func (c *Cache) download(ctx context.Context, key, rawURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	dir := filepath.Join(c.dir, filesDir)
+	f, err := os.CreateTemp(dir, "*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, h), resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to download file: %w", err)
+	}
+
+	name := hex.EncodeToString(h.Sum(nil)) + ext(rawURL, resp.Header.Get("Content-Type"))
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); err == nil {
+		// The same content was downloaded from another URL.
+		now := time.Now()
+		os.Chtimes(path, now, now)
+	} else {
+		if err := os.Rename(f.Name(), path); err != nil {
+			return "", err
+		}
+		c.grow(size)
+	}
+
+	if err := writeFile(filepath.Join(c.dir, refsDir, key), []byte(name)); err != nil {
+		slog.Error("failed to write media cache ref", "err", err, "url", rawURL)
+	}
+
+	return path, nil
+}
+
+// ext returns the extension of the file from the path of the URL or, if it has
+// none, from its content type.
+func ext(rawURL, contentType string) string {
+	if u, err := url.Parse(rawURL); err == nil {
+		if e := strings.ToLower(path.Ext(u.Path)); e != "" && len(e) <= maxExtLength {
+			return e
+		}
+	}
+
+	if exts, err := mime.ExtensionsByType(contentType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+
+	return ""
+}
+
+// writeFile atomically replaces the file with the data.
+func writeFile(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// grow adds the size of a new file to the total and starts evicting files in
+// the background if it exceeds MaxSize.
+func (c *Cache) grow(size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size += size
+	if c.opts.MaxSize > 0 && c.size > c.opts.MaxSize && !c.evicting {
+		c.evicting = true
+		go c.evict()
+	}
+}
+
+// evict removes the files that were not used within MaxAge, and then the
+// least recently used ones until their total size is within MaxSize. Refs
+// whose files were removed are removed too.
+func (c *Cache) evict() {
+	type file struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var files []file
+	entries, err := os.ReadDir(filepath.Join(c.dir, filesDir))
+	if err != nil {
+		slog.Error("failed to read media cache dir", "err", err)
+	}
+
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		files = append(files, file{filepath.Join(c.dir, filesDir, e.Name()), info.Size(), info.ModTime()})
+	}
+
+	// Latest first.
+	slices.SortFunc(files, func(a, b file) int {
+		return b.modTime.Compare(a.modTime)
+	})
+
+	var size int64
+	kept := make(map[string]struct{})
+	for _, f := range files {
+		// Temporary files are left behind by interrupted downloads. Recent
+		// ones may belong to downloads in progress.
+		evict := strings.HasSuffix(f.path, ".tmp") && time.Since(f.modTime) > time.Hour ||
+			c.opts.MaxAge > 0 && time.Since(f.modTime) > c.opts.MaxAge
+		if !evict && !strings.HasSuffix(f.path, ".tmp") {
+			size += f.size
+			evict = c.opts.MaxSize > 0 && size > c.opts.MaxSize
+		}
+
+		if !evict {
+			kept[filepath.Base(f.path)] = struct{}{}
+			continue
+		}
+
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			slog.Error("failed to evict media cache file", "err", err, "path", f.path)
+			size += f.size
+		}
+	}
+
+	c.removeRefs(kept)
+
+	c.mu.Lock()
+	c.size = size
+	c.evicting = false
+	c.mu.Unlock()
+}
+
+// removeRefs removes the refs whose files are not kept.
+func (c *Cache) removeRefs(kept map[string]struct{}) {
+	dir := filepath.Join(c.dir, refsDir)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		slog.Error("failed to read media cache refs dir", "err", err)
+		return
+	}
+
+	for _, e := range entries {
+		// Refs that are being written.
+		if strings.HasSuffix(e.Name(), ".tmp") {
+			continue
+		}
+
+		path := filepath.Join(dir, e.Name())
+		name, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := kept[string(name)]; !ok {
+			if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				slog.Error("failed to evict media cache ref", "err", err, "path", path)
+			}
+		}
+	}
+}
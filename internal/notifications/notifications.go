@@ -1,21 +1,25 @@
 package notifications
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
-	"os"
-	"path/filepath"
+	"time"
 
 	"github.com/ayn2op/discordo/internal/config"
-	"github.com/ayn2op/discordo/internal/consts"
+	"github.com/ayn2op/discordo/internal/media"
 	"github.com/diamondburned/arikawa/v3/discord"
 	"github.com/diamondburned/arikawa/v3/gateway"
 	"github.com/diamondburned/ningen/v3"
 )
 
-func Notify(state *ningen.State, message *gateway.MessageCreateEvent, cfg *config.Config) error {
+// avatarTimeout bounds the time that the avatar of the author is downloaded
+// for before the notification is shown without it.
+const avatarTimeout = 10 * time.Second
+
+// Notify shows a desktop notification of the message if the config asks for
+// it. The avatar of the author is downloaded to the media cache, if any.
+func Notify(state *ningen.State, message *gateway.MessageCreateEvent, cfg *config.Config, cache *media.Cache) error {
 	if !cfg.Notifications.Enabled || cfg.Status == discord.DoNotDisturbStatus {
 		return nil
 	}
@@ -68,14 +72,15 @@ func Notify(state *ningen.State, message *gateway.MessageCreateEvent, cfg *confi
 		title += " (#" + channel.Name + ", " + guild.Name + ")"
 	}
 
-	hash := message.Author.Avatar
-	if hash == "" {
-		hash = "default"
-	}
+	var imagePath string
+	if cache != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), avatarTimeout)
+		defer cancel()
 
-	imagePath, err := getCachedProfileImage(hash, message.Author.AvatarURLWithType(discord.PNGImage))
-	if err != nil {
-		slog.Info("failed to get profile image from cache for notification", "err", err, "hash", hash)
+		url := message.Author.AvatarURLWithType(discord.PNGImage)
+		if imagePath, err = cache.Get(ctx, url); err != nil {
+			slog.Info("failed to get profile image for notification", "err", err, "url", url)
+		}
 	}
 
 	// Play sound if enabled AND (not only_on_ping OR mentioned OR (is DM and notify_on_dm))
@@ -86,33 +91,3 @@ func Notify(state *ningen.State, message *gateway.MessageCreateEvent, cfg *confi
 
 	return nil
 }
-
-func getCachedProfileImage(avatarHash discord.Hash, url string) (string, error) {
-	path := filepath.Join(consts.CacheDir(), "avatars")
-	if err := os.MkdirAll(path, os.ModePerm); err != nil {
-		return "", err
-	}
-
-	path = filepath.Join(path, avatarHash+".png")
-	if _, err := os.Stat(path); err == nil {
-		return path, nil
-	}
-
-	file, err := os.Create(path)
-	if err != nil {
-		return "", err
-	}
-	defer file.Close()
-
-	resp, err := http.Get(url)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	if _, err := io.Copy(file, resp.Body); err != nil {
-		return "", err
-	}
-
-	return path, nil
-}
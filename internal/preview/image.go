@@ -0,0 +1,138 @@
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strings"
+
+	"github.com/gdamore/tcell/v3"
+	"golang.org/x/image/draw"
+)
+
+// maxImages is the number of images that can be told apart by the markers of
+// the graphics protocols.
+const maxImages = 4 << 8
+
+// Image is a decoded image that was downscaled to fit a number of cells.
+type Image struct {
+	id         int
+	cols, rows int
+	// pixels has two pixels per cell, one above the other, for half blocks
+	// and the size of the cells in pixels for the graphics protocols.
+	pixels *image.RGBA
+}
+
+// newImage downscales the image to fit in at most maxCols x maxRows cells of
+// cellWidth x cellHeight pixels. Images are never upscaled, but they are at
+// least one cell wide and high.
+func newImage(src image.Image, maxCols, maxRows, cellWidth, cellHeight int) *Image {
+	b := src.Bounds()
+	w, h := float64(b.Dx()), float64(b.Dy())
+	scale := min(float64(maxCols*cellWidth)/w, float64(maxRows*cellHeight)/h, 1)
+
+	cols := max(int(math.Ceil(w*scale/float64(cellWidth))), 1)
+	rows := max(int(math.Ceil(h*scale/float64(cellHeight))), 1)
+	cols, rows = min(cols, maxCols), min(rows, maxRows)
+
+	pixels := image.NewRGBA(image.Rect(0, 0, cols*cellWidth, rows*cellHeight))
+	draw.CatmullRom.Scale(pixels, pixels.Bounds(), src, b, draw.Src, nil)
+	return &Image{cols: cols, rows: rows, pixels: pixels}
+}
+
+// Lines returns the lines of text with tview style tags that draw the image.
+// Half blocks draw the pixels themselves; the graphics protocols draw blank
+// cells whose foreground color marks them as part of the image, which Overlay
+// finds on the screen to draw the image over them.
+func (img *Image) Lines(protocol Protocol) []string {
+	lines := make([]string, img.rows)
+	for row := range img.rows {
+		if protocol.Graphics() {
+			lines[row] = fmt.Sprintf("[%s]%s[-]", hex(markerColor(img.id, row)), strings.Repeat(" ", img.cols))
+		} else {
+			lines[row] = img.halfBlocks(row)
+		}
+	}
+
+	return lines
+}
+
+// halfBlocks returns the cells of the row as upper half blocks, whose
+// foreground is the upper pixel and whose background is the lower one.
+// Transparent pixels show the default background.
+func (img *Image) halfBlocks(row int) string {
+	var b strings.Builder
+	var last string
+	for col := range img.cols {
+		top, bottom := img.pixels.RGBAAt(col, row*2), img.pixels.RGBAAt(col, row*2+1)
+		topOpaque, bottomOpaque := top.A >= 0x80, bottom.A >= 0x80
+
+		var tag, cell string
+		switch {
+		case topOpaque && bottomOpaque:
+			tag, cell = "["+hex(top)+":"+hex(bottom)+"]", "▀"
+		case topOpaque:
+			tag, cell = "["+hex(top)+":-]", "▀"
+		case bottomOpaque:
+			tag, cell = "["+hex(bottom)+":-]", "▄"
+		default:
+			tag, cell = "[-:-]", " "
+		}
+
+		if tag != last {
+			b.WriteString(tag)
+			last = tag
+		}
+		b.WriteString(cell)
+	}
+
+	b.WriteString("[-:-]")
+	return b.String()
+}
+
+// crop returns the pixels of the rows from first to last.
+func (img *Image) crop(first, last int) image.Image {
+	cellHeight := img.pixels.Bounds().Dy() / img.rows
+	return img.pixels.SubImage(image.Rect(0, first*cellHeight, img.pixels.Bounds().Dx(), (last+1)*cellHeight))
+}
+
+// encodePNG encodes the image as PNG, favoring speed over size since it is
+// sent to the terminal right away.
+func encodePNG(img image.Image) []byte {
+	var b bytes.Buffer
+	enc := png.Encoder{CompressionLevel: png.BestSpeed}
+	if err := enc.Encode(&b, img); err != nil {
+		return nil
+	}
+
+	return b.Bytes()
+}
+
+func hex(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, b>>8)
+}
+
+// markerColor returns the foreground color of the cells of the row of the
+// image. Such dark colors are not expected on blank cells otherwise.
+func markerColor(id, row int) color.Color {
+	return color.RGBA{R: uint8(1 + id>>8), G: uint8(id), B: uint8(row), A: 0xff}
+}
+
+// parseMarker returns the image and the row that the foreground color of a
+// cell marks.
+func parseMarker(c tcell.Color) (id, row int, ok bool) {
+	if !c.IsRGB() {
+		return 0, 0, false
+	}
+
+	r, g, b := c.RGB()
+	if r < 1 || r > maxImages>>8 {
+		return 0, 0, false
+	}
+
+	return int(r-1)<<8 | int(g), int(b), true
+}
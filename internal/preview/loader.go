@@ -0,0 +1,260 @@
+package preview
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ayn2op/discordo/internal/media"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	// workers is the number of images that are loaded at the same time.
+	workers = 2
+	// downloadTimeout bounds the time that an image is downloaded for.
+	downloadTimeout = 30 * time.Second
+	// maxFileSize and maxPixels bound the images that are loaded.
+	maxFileSize = 32 << 20
+	maxPixels   = 64 << 20
+	// maxImageRows is the number of rows that the markers of the graphics
+	// protocols can tell apart.
+	maxImageRows = 256
+	// failedSize is the size that is accounted for images that failed to load,
+	// so that they are not loaded again until they are evicted.
+	failedSize = 1 << 10
+)
+
+type key struct {
+	url        string
+	cols, rows int
+}
+
+type entry struct {
+	key   key
+	image *Image // nil if it failed to load
+	size  int
+}
+
+// Loader downloads, decodes and downscales images in the background and keeps
+// them in a cache of a limited size, evicting the least recently used ones.
+// The downloaded files are kept in the media cache.
+type Loader struct {
+	media    *media.Cache
+	protocol Protocol
+	// cellWidth and cellHeight are the size of a cell in pixels.
+	cellWidth, cellHeight int
+	maxSize               int
+	onChange              func(url string)
+
+	mu      sync.Mutex
+	entries map[key]*list.Element
+	lru     *list.List
+	size    int
+	images  map[int]*Image
+	nextID  int
+	pending map[key]struct{}
+	queue   chan key
+}
+
+// NewLoader creates a loader that keeps at most maxSize bytes of images and
+// starts its workers. The images are scaled for the protocol and the size of
+// the cells in pixels, which must be known for the graphics protocols.
+// onChange is called from a worker after an image of the URL was loaded or
+// evicted; the text that was drawn for an evicted image refers to an ID that
+// may be given to another image, so it has to be drawn again.
+func NewLoader(media *media.Cache, protocol Protocol, cellWidth, cellHeight, maxSize int, onChange func(url string)) *Loader {
+	if !protocol.Graphics() {
+		// Two pixels per cell, one above the other.
+		cellWidth, cellHeight = 1, 2
+	}
+
+	l := &Loader{
+		media:      media,
+		protocol:   protocol,
+		cellWidth:  cellWidth,
+		cellHeight: cellHeight,
+		maxSize:    maxSize,
+		onChange:   onChange,
+
+		entries: make(map[key]*list.Element),
+		lru:     list.New(),
+		images:  make(map[int]*Image),
+		pending: make(map[key]struct{}),
+		queue:   make(chan key, 64),
+	}
+
+	for range workers {
+		go l.run()
+	}
+
+	return l
+}
+
+// Protocol returns the protocol that the images are scaled for.
+func (l *Loader) Protocol() Protocol {
+	return l.protocol
+}
+
+// Get returns the image of the URL downscaled to fit in maxCols x maxRows
+// cells. If it is not loaded yet, it is queued to be loaded and false is
+// returned.
+func (l *Loader) Get(url string, maxCols, maxRows int) (*Image, bool) {
+	k := key{url, max(maxCols, 1), min(max(maxRows, 1), maxImageRows)}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e, ok := l.entries[k]; ok {
+		l.lru.MoveToFront(e)
+		img := e.Value.(*entry).image
+		return img, img != nil
+	}
+
+	if _, ok := l.pending[k]; !ok {
+		l.pending[k] = struct{}{}
+		select {
+		case l.queue <- k:
+		default:
+			// The queue is full; the image is queued once a worker is free.
+			go func() { l.queue <- k }()
+		}
+	}
+
+	return nil, false
+}
+
+// image returns the loaded image with the ID.
+func (l *Loader) image(id int) *Image {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.images[id]
+}
+
+func (l *Loader) run() {
+	for k := range l.queue {
+		img, err := l.load(k)
+		if err != nil {
+			slog.Error("failed to load image preview", "err", err, "url", k.url)
+		}
+
+		evicted := l.add(k, img)
+		if l.onChange == nil {
+			continue
+		}
+
+		if img != nil {
+			l.onChange(k.url)
+		}
+		for _, url := range evicted {
+			l.onChange(url)
+		}
+	}
+}
+
+func (l *Loader) load(k key) (*Image, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), downloadTimeout)
+	defer cancel()
+
+	path, err := l.media.Get(ctx, k.url)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() > maxFileSize {
+		return nil, errors.New("image is too large")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("unsupported image size %dx%d", cfg.Width, cfg.Height)
+	}
+
+	// Only the first frame of animated images is decoded.
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return newImage(src, k.cols, k.rows, l.cellWidth, l.cellHeight), nil
+}
+
+// add caches the image, or that it failed to load if it is nil, and evicts the
+// least recently used images while the cache is too large. It returns the
+// URLs of the evicted images.
+func (l *Loader) add(k key, img *Image) []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var evicted []string
+	evict := func() bool {
+		e := l.evict()
+		if e != nil && e.image != nil {
+			evicted = append(evicted, e.key.url)
+		}
+		return e != nil
+	}
+
+	delete(l.pending, k)
+
+	e := &entry{key: k, image: img, size: failedSize}
+	if img != nil {
+		// Make room for the ID of the image.
+		for len(l.images) >= maxImages && evict() {
+		}
+
+		for l.images[l.nextID] != nil {
+			l.nextID = (l.nextID + 1) % maxImages
+		}
+		img.id = l.nextID
+		l.images[img.id] = img
+		l.nextID = (l.nextID + 1) % maxImages
+
+		e.size = len(img.pixels.Pix)
+	}
+
+	l.entries[k] = l.lru.PushFront(e)
+	l.size += e.size
+	for l.size > l.maxSize && l.lru.Len() > 1 && evict() {
+	}
+	return evicted
+}
+
+// evict drops the least recently used image and returns it, or nil if there
+// was none.
+func (l *Loader) evict() *entry {
+	back := l.lru.Back()
+	if back == nil {
+		return nil
+	}
+
+	e := l.lru.Remove(back).(*entry)
+	delete(l.entries, e.key)
+	if e.image != nil {
+		delete(l.images, e.image.id)
+	}
+	l.size -= e.size
+	return e
+}
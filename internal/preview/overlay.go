@@ -0,0 +1,261 @@
+package preview
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/gdamore/tcell/v3"
+)
+
+// kittyChunkSize is the size of the chunks of base64 data that images are
+// sent to kitty in.
+const kittyChunkSize = 4096
+
+// placement is where an image is drawn on the screen. Only the rows from
+// first to last are drawn when the image is scrolled partially out of view.
+type placement struct {
+	image       *Image
+	x, y        int
+	first, last int
+}
+
+type run struct {
+	x, y, length int
+}
+
+// Overlay draws the images of a loader with a graphics protocol over the
+// cells that are marked as theirs. The cells under the images are locked so
+// that the screen does not draw over them.
+type Overlay struct {
+	loader *Loader
+
+	placements map[int]placement
+	// encoded caches the data that was last sent for each image, as
+	// encoding it again on every draw would be slow.
+	encoded map[int]encodedImage
+	// transmitted holds the images that kitty keeps by their IDs.
+	transmitted   map[int]*Image
+	width, height int
+	reset         bool
+}
+
+type encodedImage struct {
+	placement placement
+	data      []byte
+}
+
+// NewOverlay creates an overlay for the images of the loader, which must use
+// a graphics protocol.
+func NewOverlay(loader *Loader) *Overlay {
+	return &Overlay{
+		loader:      loader,
+		placements:  make(map[int]placement),
+		encoded:     make(map[int]encodedImage),
+		transmitted: make(map[int]*Image),
+	}
+}
+
+// Reset makes the next draw draw the images again, e.g. after the terminal was
+// used by another program.
+func (o *Overlay) Reset() {
+	o.reset = true
+}
+
+// clear forgets the images that were drawn and removes them from kitty.
+func (o *Overlay) clear(screen tcell.Screen, w io.Writer) {
+	for _, p := range o.placements {
+		screen.LockRegion(p.x, p.y, p.image.cols, p.last-p.first+1, false)
+	}
+
+	clear(o.placements)
+	clear(o.transmitted)
+
+	if o.loader.protocol == ProtocolKitty {
+		io.WriteString(w, "\x1b_Ga=d,d=a,q=2\x1b\\")
+	}
+}
+
+// Draw draws the images whose cells are within the rectangle of the screen. It
+// must be called after everything else is drawn and before the screen is
+// shown. Images that are partially covered are not drawn, except for those
+// that are scrolled partially out of the rectangle.
+func (o *Overlay) Draw(screen tcell.Screen, x, y, width, height int) {
+	tty, ok := screen.Tty()
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	// The screen is cleared when it is resized.
+	if w, h := screen.Size(); o.reset || w != o.width || h != o.height {
+		o.width, o.height = w, h
+		o.reset = false
+		o.clear(screen, &buf)
+	}
+
+	placements := o.find(screen, x, y, width, height)
+	for id, p := range o.placements {
+		if placements[id] != p {
+			o.remove(screen, &buf, id, p)
+		}
+	}
+	for id, p := range placements {
+		if o.placements[id] != p {
+			o.place(screen, &buf, id, p)
+		}
+	}
+	o.placements = placements
+
+	if buf.Len() > 0 {
+		if _, err := tty.Write(buf.Bytes()); err != nil {
+			slog.Error("failed to draw image previews", "err", err)
+		}
+	}
+}
+
+// find returns the placements of the images whose cells are in the rectangle.
+func (o *Overlay) find(screen tcell.Screen, x, y, width, height int) map[int]placement {
+	// The first run of marked cells of each row of each image.
+	runs := make(map[int]map[int]run)
+	for row := y; row < y+height; row++ {
+		for col := x; col < x+width; col++ {
+			id, imageRow, ok := markerAt(screen, col, row)
+			if !ok {
+				continue
+			}
+
+			start := col
+			for col+1 < x+width {
+				nextID, nextRow, ok := markerAt(screen, col+1, row)
+				if !ok || nextID != id || nextRow != imageRow {
+					break
+				}
+				col++
+			}
+
+			if runs[id] == nil {
+				runs[id] = make(map[int]run)
+			}
+			if _, ok := runs[id][imageRow]; !ok {
+				runs[id][imageRow] = run{start, row, col - start + 1}
+			}
+		}
+	}
+
+	placements := make(map[int]placement)
+	for id, rows := range runs {
+		img := o.loader.image(id)
+		if img == nil {
+			continue
+		}
+
+		first, last := img.rows, -1
+		for r := range rows {
+			first, last = min(first, r), max(last, r)
+		}
+
+		top := rows[first]
+		visible := last < img.rows &&
+			(first == 0 || top.y == y) &&
+			(last == img.rows-1 || rows[last].y == y+height-1)
+		for r := first; visible && r <= last; r++ {
+			rr, ok := rows[r]
+			visible = ok && rr.x == top.x && rr.y == top.y+r-first && rr.length == img.cols
+		}
+
+		if visible {
+			placements[id] = placement{image: img, x: top.x, y: top.y, first: first, last: last}
+		}
+	}
+
+	return placements
+}
+
+func markerAt(screen tcell.Screen, x, y int) (id, row int, ok bool) {
+	str, style, _ := screen.Get(x, y)
+	if str != " " {
+		return 0, 0, false
+	}
+
+	return parseMarker(style.GetForeground())
+}
+
+func (o *Overlay) remove(screen tcell.Screen, w io.Writer, id int, p placement) {
+	screen.LockRegion(p.x, p.y, p.image.cols, p.last-p.first+1, false)
+	if o.loader.protocol == ProtocolKitty {
+		fmt.Fprintf(w, "\x1b_Ga=d,d=i,i=%d,q=2\x1b\\", id+1)
+	}
+}
+
+func (o *Overlay) place(screen tcell.Screen, w io.Writer, id int, p placement) {
+	img := p.image
+	rows := p.last - p.first + 1
+	screen.LockRegion(p.x, p.y, img.cols, rows, true)
+
+	// Save the cursor and move it to the top left cell of the image. The
+	// cursor is restored afterwards, as the screen expects it where it left
+	// it.
+	fmt.Fprintf(w, "\x1b7\x1b[%d;%dH", p.y+1, p.x+1)
+
+	switch o.loader.protocol {
+	case ProtocolKitty:
+		// kitty keeps the images, so they are sent once and only the visible
+		// rows are placed.
+		if o.transmitted[id] != img {
+			writeKittyImage(w, id+1, encodePNG(img.pixels))
+			o.transmitted[id] = img
+		}
+
+		cellHeight := img.pixels.Bounds().Dy() / img.rows
+		fmt.Fprintf(
+			w,
+			"\x1b_Ga=p,i=%d,p=1,y=%d,h=%d,c=%d,r=%d,C=1,q=2\x1b\\",
+			id+1, p.first*cellHeight, rows*cellHeight, img.cols, rows,
+		)
+	case ProtocolITerm2:
+		data := o.encode(id, p, func() []byte {
+			return []byte(base64.StdEncoding.EncodeToString(encodePNG(img.crop(p.first, p.last))))
+		})
+		fmt.Fprintf(w, "\x1b]1337;File=inline=1;width=%d;height=%d;preserveAspectRatio=0:%s\a", img.cols, rows, data)
+	case ProtocolSixel:
+		w.Write(o.encode(id, p, func() []byte {
+			return encodeSixel(img.crop(p.first, p.last))
+		}))
+	}
+
+	io.WriteString(w, "\x1b8")
+}
+
+// encode returns the data of the placement, encoding it only if it differs
+// from the previous placement of the image.
+func (o *Overlay) encode(id int, p placement, encode func() []byte) []byte {
+	e, ok := o.encoded[id]
+	if ok && e.placement.image == p.image && e.placement.first == p.first && e.placement.last == p.last {
+		return e.data
+	}
+
+	e = encodedImage{placement: p, data: encode()}
+	o.encoded[id] = e
+	return e.data
+}
+
+// writeKittyImage sends the PNG data to kitty in chunks.
+func writeKittyImage(w io.Writer, id int, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for i := 0; i < len(encoded); i += kittyChunkSize {
+		chunk := encoded[i:min(i+kittyChunkSize, len(encoded))]
+		more := 0
+		if i+kittyChunkSize < len(encoded) {
+			more = 1
+		}
+
+		if i == 0 {
+			fmt.Fprintf(w, "\x1b_Ga=t,f=100,i=%d,q=2,m=%d;%s\x1b\\", id, more, chunk)
+		} else {
+			fmt.Fprintf(w, "\x1b_Gm=%d;%s\x1b\\", more, chunk)
+		}
+	}
+}
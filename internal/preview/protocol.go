@@ -0,0 +1,53 @@
+// Package preview draws downscaled previews of images in the terminal, either
+// with a graphics protocol of the terminal or with Unicode half blocks.
+package preview
+
+import (
+	"os"
+	"strings"
+)
+
+// Protocol is the way the previews are drawn.
+type Protocol string
+
+const (
+	ProtocolAuto      Protocol = "auto"
+	ProtocolKitty     Protocol = "kitty"
+	ProtocolITerm2    Protocol = "iterm2"
+	ProtocolSixel     Protocol = "sixel"
+	ProtocolHalfBlock Protocol = "halfblock"
+)
+
+// Graphics reports whether the protocol draws the previews as pixels instead
+// of text.
+func (p Protocol) Graphics() bool {
+	switch p {
+	case ProtocolKitty, ProtocolITerm2, ProtocolSixel:
+		return true
+	default:
+		return false
+	}
+}
+
+// DetectProtocol guesses the graphics protocol that the terminal supports from
+// its name, as reported by the terminal itself, and the environment. Unknown
+// terminals fall back to half blocks.
+func DetectProtocol(terminalName string) Protocol {
+	name := strings.ToLower(terminalName)
+	term := strings.ToLower(os.Getenv("TERM"))
+	program := strings.ToLower(os.Getenv("TERM_PROGRAM"))
+
+	switch {
+	case strings.Contains(name, "kitty"), strings.Contains(name, "ghostty"),
+		os.Getenv("KITTY_WINDOW_ID") != "", term == "xterm-kitty", program == "ghostty":
+		return ProtocolKitty
+	case strings.Contains(name, "iterm2"), strings.Contains(name, "wezterm"),
+		program == "iterm.app", program == "wezterm":
+		return ProtocolITerm2
+	case strings.Contains(name, "foot"), strings.Contains(name, "mlterm"), strings.Contains(name, "contour"),
+		strings.HasPrefix(term, "foot"), strings.HasPrefix(term, "mlterm"), program == "contour":
+		return ProtocolSixel
+	default:
+		return ProtocolHalfBlock
+	}
+}
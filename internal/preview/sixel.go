@@ -0,0 +1,107 @@
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color/palette"
+
+	"golang.org/x/image/draw"
+)
+
+// encodeSixel encodes the image as sixel graphics. The colors are reduced to
+// a fixed palette with dithering and transparent pixels are left unset.
+func encodeSixel(img image.Image) []byte {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	paletted := image.NewPaletted(image.Rect(0, 0, w, h), palette.Plan9)
+	draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), img, b.Min)
+
+	// indices holds the palette index of each pixel, or -1 if the pixel is
+	// transparent.
+	indices := make([]int, w*h)
+	used := make([]bool, len(palette.Plan9))
+	for y := range h {
+		for x := range w {
+			i := -1
+			if _, _, _, a := img.At(b.Min.X+x, b.Min.Y+y).RGBA(); a >= 0x8000 {
+				i = int(paletted.ColorIndexAt(x, y))
+				used[i] = true
+			}
+			indices[y*w+x] = i
+		}
+	}
+
+	var buf bytes.Buffer
+	// P2 = 1 leaves the unset pixels as they are.
+	fmt.Fprintf(&buf, "\x1bP0;1;0q\"1;1;%d;%d", w, h)
+
+	for i, c := range palette.Plan9 {
+		if used[i] {
+			r, g, b, _ := c.RGBA()
+			fmt.Fprintf(&buf, "#%d;2;%d;%d;%d", i, r*100/0xffff, g*100/0xffff, b*100/0xffff)
+		}
+	}
+
+	sixels := make([]byte, w)
+	for y := 0; y < h; y += 6 {
+		// The colors that are used in the band of six rows.
+		bandColors := make(map[int]bool)
+		for x := range w {
+			for dy := 0; dy < 6 && y+dy < h; dy++ {
+				if i := indices[(y+dy)*w+x]; i != -1 {
+					bandColors[i] = true
+				}
+			}
+		}
+
+		first := true
+		for i := range used {
+			if !bandColors[i] {
+				continue
+			}
+
+			for x := range w {
+				var bits byte
+				for dy := 0; dy < 6 && y+dy < h; dy++ {
+					if indices[(y+dy)*w+x] == i {
+						bits |= 1 << dy
+					}
+				}
+				sixels[x] = '?' + bits
+			}
+
+			if !first {
+				// Go back to the start of the band for the next color.
+				buf.WriteByte('$')
+			}
+			first = false
+
+			fmt.Fprintf(&buf, "#%d", i)
+			writeSixelRuns(&buf, sixels)
+		}
+
+		buf.WriteByte('-')
+	}
+
+	buf.WriteString("\x1b\\")
+	return buf.Bytes()
+}
+
+// writeSixelRuns writes the sixels with repeated ones compressed.
+func writeSixelRuns(buf *bytes.Buffer, sixels []byte) {
+	for i := 0; i < len(sixels); {
+		j := i
+		for j < len(sixels) && sixels[j] == sixels[i] {
+			j++
+		}
+
+		if n := j - i; n > 3 {
+			fmt.Fprintf(buf, "!%d%c", n, sixels[i])
+		} else {
+			buf.Write(sixels[i:j])
+		}
+		i = j
+	}
+}
@@ -170,12 +170,13 @@ func (ml *messagesList) drawAuthor(w io.Writer, message discord.Message) {
 }
 
 func (ml *messagesList) drawContent(w io.Writer, message discord.Message) {
-	c := []byte(tview.Escape(message.Content))
 	if ml.chatView.cfg.Markdown {
+		// The renderer escapes the text itself.
+		c := []byte(message.Content)
 		ast := discordmd.ParseWithMessage(c, *ml.chatView.state.Cabinet, &message, false)
 		ml.renderer.Render(w, c, ast)
 	} else {
-		w.Write(c) // write the content as is
+		io.WriteString(w, tview.Escape(message.Content)) // write the content as is
 	}
 }
 
@@ -282,7 +282,7 @@ func (v *View) onMessageCreate(message *gateway.MessageCreateEvent) {
 		v.app.Draw()
 	}
 
-	if err := notifications.Notify(v.state, message, v.cfg); err != nil {
+	if err := notifications.Notify(v.state, message, v.cfg, nil); err != nil {
 		slog.Error("failed to notify", "err", err, "channel_id", message.ChannelID, "message_id", message.ID)
 	}
 }